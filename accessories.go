@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// --- Machine accessories ---
+// Front sheet supports, side gauges and hold-down clamps are bolted to the
+// bed and stay there between jobs, so they belong in the machine profile.
+// Each accessory occupies a span along the bed and has a working height;
+// the per-step check reports when a formed flange sweeps through one, and
+// the bed top-view draws them so an off-center setup can be sanity-checked
+// before walking to the machine.
+
+// AccessoryKind classifies a bed-mounted accessory.
+type AccessoryKind string
+
+const (
+	AccessoryFrontSupport AccessoryKind = "Front Support" // Arm in front of the die carrying the blank.
+	AccessorySideGauge    AccessoryKind = "Side Gauge"    // Lateral stop the sheet edge registers on.
+	AccessoryClamp        AccessoryKind = "Clamp"         // Hold-down clamping the sheet to the bed.
+)
+
+// Accessory is one bed-mounted fixture in the machine profile.
+type Accessory struct {
+	Name     string
+	Kind     AccessoryKind
+	XStartMM float64 // Span along the bed, from the left bed edge.
+	XEndMM   float64
+	HeightMM float64 // Working height above the die top.
+}
+
+// AddAccessory registers a fixture in the machine profile.
+func (pb *PressBrake) AddAccessory(a Accessory) error {
+	if a.Name == "" {
+		return fmt.Errorf("accessory name cannot be empty")
+	}
+	if a.XEndMM <= a.XStartMM {
+		return fmt.Errorf("accessory '%s' has an empty bed span (%.0f..%.0fmm)", a.Name, a.XStartMM, a.XEndMM)
+	}
+	pb.Accessories = append(pb.Accessories, a)
+	return nil
+}
+
+// sheetBedSpan returns the span a sheet occupies along the bed for a step,
+// honoring the step's station offset. The second result is false when the
+// bed length is unknown.
+func sheetBedSpan(sheet *SheetMetal, stationOffsetMM float64, pb *PressBrake) (start, end float64, ok bool) {
+	if pb.BedLength() <= 0 || sheet == nil {
+		return 0, 0, false
+	}
+	center := pb.BedLength()/2 + stationOffsetMM
+	return center - sheet.Width/2, center + sheet.Width/2, true
+}
+
+// spansOverlap reports whether two bed spans intersect.
+func spansOverlap(aStart, aEnd, bStart, bEnd float64) bool {
+	return aStart < bEnd && bStart < aEnd
+}
+
+// CheckAccessoryInterference walks the bend sequence and reports, per
+// step, accessories the part will hit. Side gauges and clamps interfere
+// when the sheet span covers them; front supports additionally need the
+// up-swinging front flange to rise above their working height.
+func CheckAccessoryInterference(j *Job, pb *PressBrake) ([]string, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for interference check")
+	}
+	if pb == nil {
+		return nil, fmt.Errorf("press brake is nil for interference check")
+	}
+	if len(pb.Accessories) == 0 || pb.BedLength() <= 0 {
+		return nil, nil // Nothing mounted or bed geometry unknown.
+	}
+
+	var warnings []string
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		sStart, sEnd, ok := sheetBedSpan(j.Sheet, step.StationOffsetMM, pb)
+		if !ok {
+			continue
+		}
+		rot := (180.0 - step.TargetAngle) * math.Pi / 180.0
+		frontRise := step.Position * math.Sin(rot)
+
+		for _, acc := range pb.Accessories {
+			if !spansOverlap(sStart, sEnd, acc.XStartMM, acc.XEndMM) {
+				continue
+			}
+			switch acc.Kind {
+			case AccessoryFrontSupport:
+				if step.Direction == BendDirectionUp && frontRise > acc.HeightMM {
+					warnings = append(warnings, fmt.Sprintf(
+						"step %d: front flange rises %.0fmm and sweeps through %s '%s' (height %.0fmm)",
+						i+1, frontRise, acc.Kind, acc.Name, acc.HeightMM))
+				}
+			default:
+				warnings = append(warnings, fmt.Sprintf(
+					"step %d: sheet span %.0f..%.0fmm covers %s '%s' at %.0f..%.0fmm",
+					i+1, sStart, sEnd, acc.Kind, acc.Name, acc.XStartMM, acc.XEndMM))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// GenerateBedTopViewSVG draws the bed from above: usable bed length, the
+// sheet span for the first enabled step (or centered when there are no
+// steps), and every mounted accessory, using the active render profile.
+func GenerateBedTopViewSVG(j *Job, pb *PressBrake, filePath string) error {
+	if pb == nil || pb.BedLength() <= 0 {
+		return fmt.Errorf("press brake bed length is not configured")
+	}
+	rp := GetExportRenderProfile()
+	const svgW, svgH, pad = 800.0, 220.0, 20.0
+	scale := (svgW - 2*pad) / pb.BedLength()
+	toX := func(mm float64) float64 { return pad + mm*scale }
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f">`+"\n", svgW, svgH))
+	sb.WriteString(fmt.Sprintf(`<rect width="100%%" height="100%%" fill="%s"/>`+"\n", rp.Background))
+
+	// Bed.
+	sb.WriteString(fmt.Sprintf(`<rect x="%.1f" y="90" width="%.1f" height="40" fill="none" stroke="%s" stroke-width="%.1f"/>`+"\n",
+		toX(0), pb.BedLength()*scale, rp.OutlineColor, rp.OutlineWeight))
+	sb.WriteString(fmt.Sprintf(`<text x="%.1f" y="85" font-size="11" fill="%s">%s bed, %.0fmm</text>`+"\n",
+		toX(0), rp.TextColor, pb.Name, pb.BedLength()))
+
+	// Sheet span for the first enabled step.
+	if j != nil && j.Sheet != nil {
+		offset := 0.0
+		for _, step := range j.Steps {
+			if step != nil && !step.Disabled {
+				offset = step.StationOffsetMM
+				break
+			}
+		}
+		if start, end, ok := sheetBedSpan(j.Sheet, offset, pb); ok {
+			sb.WriteString(fmt.Sprintf(`<rect x="%.1f" y="96" width="%.1f" height="28" fill="%s" stroke="%s" stroke-width="%.1f"/>`+"\n",
+				toX(start), (end-start)*scale, rp.SheetFill, rp.OutlineColor, rp.DetailWeight))
+			sb.WriteString(fmt.Sprintf(`<text x="%.1f" y="150" font-size="10" fill="%s">sheet %.0f..%.0fmm</text>`+"\n",
+				toX(start), rp.TextColor, start, end))
+		}
+	}
+
+	// Accessories below the bed line.
+	for i, acc := range pb.Accessories {
+		y := 160.0 + float64(i%3)*18
+		sb.WriteString(fmt.Sprintf(`<rect x="%.1f" y="%.1f" width="%.1f" height="12" fill="none" stroke="%s" stroke-width="%.1f"/>`+"\n",
+			toX(acc.XStartMM), y, (acc.XEndMM-acc.XStartMM)*scale, rp.OutlineColor, rp.DetailWeight))
+		sb.WriteString(fmt.Sprintf(`<text x="%.1f" y="%.1f" font-size="9" fill="%s">%s (%s)</text>`+"\n",
+			toX(acc.XStartMM)+2, y+10, rp.TextColor, acc.Name, acc.Kind))
+	}
+
+	sb.WriteString("</svg>\n")
+	if err := os.WriteFile(filePath, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("writing bed top view '%s': %w", filePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// --- Instructor / administrator lock ---
+// Training centers want students to program jobs freely but not to edit
+// materials, machines or validation rules. A simple PIN lock covers this:
+// an instructor sets a PIN and locks the configuration; protected edit
+// paths call RequireConfigUnlocked and refuse while locked.
+
+// AdminLock is a PIN-based lock over configuration edits.
+type AdminLock struct {
+	mu      sync.Mutex
+	pinHash [32]byte
+	hasPIN  bool
+	locked  bool
+}
+
+// adminLock is the application-wide configuration lock.
+var adminLock = &AdminLock{}
+
+// GetAdminLock returns the application-wide configuration lock.
+func GetAdminLock() *AdminLock { return adminLock }
+
+// SetPIN sets the unlock PIN. Only allowed while unlocked.
+func (al *AdminLock) SetPIN(pin string) error {
+	if len(pin) < 4 {
+		return fmt.Errorf("PIN must be at least 4 characters")
+	}
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if al.locked {
+		return fmt.Errorf("cannot change PIN while configuration is locked")
+	}
+	al.pinHash = sha256.Sum256([]byte(pin))
+	al.hasPIN = true
+	log.Println("INFO: Administrator PIN set.")
+	return nil
+}
+
+// Lock engages the configuration lock. A PIN must have been set first.
+func (al *AdminLock) Lock() error {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if !al.hasPIN {
+		return fmt.Errorf("set an administrator PIN before locking")
+	}
+	al.locked = true
+	log.Println("INFO: Configuration locked (materials, machines and rules are read-only).")
+	return nil
+}
+
+// Unlock disengages the lock when the correct PIN is supplied.
+func (al *AdminLock) Unlock(pin string) error {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	hash := sha256.Sum256([]byte(pin))
+	if subtle.ConstantTimeCompare(hash[:], al.pinHash[:]) != 1 {
+		log.Println("WARNING: Failed configuration unlock attempt.")
+		return fmt.Errorf("incorrect PIN")
+	}
+	al.locked = false
+	log.Println("INFO: Configuration unlocked.")
+	return nil
+}
+
+// IsLocked reports whether configuration edits are currently blocked.
+func (al *AdminLock) IsLocked() bool {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	return al.locked
+}
+
+// RequireConfigUnlocked is called by protected edit paths; it returns a
+// descriptive error while the lock is engaged.
+func RequireConfigUnlocked(what string) error {
+	if adminLock.IsLocked() {
+		return fmt.Errorf("%s is locked by the instructor/administrator PIN", what)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// --- Bend allowance reference chart ---
+// Shops trust their own deduction tables; before trusting a computed flat
+// pattern they want to compare. This view tabulates bend allowance and bend
+// deduction versus angle for the current material/thickness/tool combo, as
+// computed by the same estimator the simulation uses.
+
+// AllowanceChartRow is one line of the reference chart.
+type AllowanceChartRow struct {
+	AngleDeg      float64 // Internal bend angle.
+	InnerRadius   float64 // Effective inner radius used (mm).
+	KFactor       float64 // Neutral axis factor used.
+	BendAllowance float64 // Arc length of the neutral axis (mm).
+	BendDeduction float64 // Flat-length deduction for this bend (mm).
+}
+
+// estimateKFactor returns the neutral-axis factor for a radius/thickness
+// ratio, following the common rule of thumb (tight bends shift the neutral
+// axis inward).
+func estimateKFactor(innerRadius, thickness float64) float64 {
+	if thickness <= 0 {
+		return 0.5
+	}
+	ratio := innerRadius / thickness
+	switch {
+	case ratio < 1:
+		return 0.33
+	case ratio < 3:
+		return 0.33 + (ratio-1)*0.085 // Linear blend toward 0.5.
+	default:
+		return 0.5
+	}
+}
+
+// allowanceForAngle computes bend allowance and deduction for one angle.
+// A programmed "sharp" radius is clamped to the coining floor first so the
+// developed length reflects what the part actually forms.
+func allowanceForAngle(angleDeg, innerRadius, thickness float64) (allowance, deduction float64) {
+	if isFlatteningAngle(angleDeg) {
+		// Closed folds leave the setback formula's domain; see flattening.go.
+		return flatteningAllowance(thickness)
+	}
+	innerRadius = EffectiveInnerRadius(innerRadius, thickness)
+	k := estimateKFactor(innerRadius, thickness)
+	angleRad := (180.0 - angleDeg) * math.Pi / 180.0 // Swept (complementary) angle.
+	allowance = angleRad * (innerRadius + k*thickness)
+	// Outside setback for the two tangent legs.
+	setback := (innerRadius + thickness) * math.Tan(angleRad/2)
+	deduction = 2*setback - allowance
+	return allowance, deduction
+}
+
+// BuildAllowanceChart tabulates allowance/deduction per angle for the given
+// sheet and tooling. The effective inner radius is the larger of the punch
+// tip radius and the material's natural air-bend radius in the die.
+func BuildAllowanceChart(sheet *SheetMetal, punch *Punch, die *Die, angles []float64) ([]AllowanceChartRow, error) {
+	if sheet == nil {
+		return nil, fmt.Errorf("sheet is nil for allowance chart")
+	}
+	innerRadius := 1.0
+	if punch != nil && punch.Radius > 0 {
+		innerRadius = punch.Radius
+	}
+	if die != nil && die.VOpening > 0 {
+		// Air bending: the part takes roughly V/6 as a natural radius.
+		if natural := die.VOpening / 6.0; natural > innerRadius {
+			innerRadius = natural
+		}
+	}
+
+	rows := make([]AllowanceChartRow, 0, len(angles))
+	for _, ang := range angles {
+		if ang < minBendAngle || ang > maxBendAngle {
+			continue
+		}
+		vOpening := 0.0
+		if die != nil {
+			vOpening = die.VOpening
+		}
+		ba, bd := allowanceWithTable(sheet.Material.Name, sheet.Thickness, vOpening, ang, innerRadius)
+		rows = append(rows, AllowanceChartRow{
+			AngleDeg:      ang,
+			InnerRadius:   innerRadius,
+			KFactor:       estimateKFactor(innerRadius, sheet.Thickness),
+			BendAllowance: ba,
+			BendDeduction: bd,
+		})
+	}
+	return rows, nil
+}
+
+// defaultChartAngles are the angles tabulated in the reference view.
+var defaultChartAngles = []float64{30, 45, 60, 75, 90, 105, 120, 135, 150, 165}
+
+// FormatAllowanceChart renders chart rows as fixed-width text for display
+// in the reference dialog and for inclusion in exported documents.
+func FormatAllowanceChart(sheet *SheetMetal, punch *Punch, die *Die, rows []AllowanceChartRow) string {
+	var sb strings.Builder
+	punchName, dieName := "-", "-"
+	if punch != nil {
+		punchName = punch.Name
+	}
+	if die != nil {
+		dieName = die.Name
+	}
+	sb.WriteString(fmt.Sprintf("Material: %s  T:%.1fmm  Punch: %s  Die: %s\n", sheet.Material.Name, sheet.Thickness, punchName, dieName))
+	sb.WriteString("Angle   R(in)   K      BA      BD\n")
+	for _, r := range rows {
+		sb.WriteString(fmt.Sprintf("%5.0f° %6.2f %5.2f %7.2f %7.2f\n", r.AngleDeg, r.InnerRadius, r.KFactor, r.BendAllowance, r.BendDeduction))
+	}
+	return sb.String()
+}
+
+// showAllowanceChart opens the reference chart for the current sheet and
+// tooling in a dialog.
+func (ac *AppController) showAllowanceChart() {
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil {
+		ac.updateStatus("No active sheet for allowance chart.", true)
+		return
+	}
+	rows, err := BuildAllowanceChart(ac.currentJob.Sheet, ac.pressBrake.GetCurrentPunch(), ac.pressBrake.GetCurrentDie(), defaultChartAngles)
+	if err != nil {
+		ac.updateStatus(fmt.Sprintf("Allowance chart error: %v", err), true)
+		return
+	}
+	text := FormatAllowanceChart(ac.currentJob.Sheet, ac.pressBrake.GetCurrentPunch(), ac.pressBrake.GetCurrentDie(), rows)
+	ac.showConfirmDialog("Bend Allowance Chart", text, nil, nil)
+}
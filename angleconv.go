@@ -0,0 +1,66 @@
+package main
+
+import "fmt"
+
+// --- Angle conventions ---
+// Drawings dimension bends as internal angles, external angles or the
+// complement ("bend up 30°" meaning a 150° internal angle). Internally
+// everything stays the internal angle, as it always has; the convention
+// only controls how the UI and exports read and print values, so the
+// stored jobs are unambiguous no matter how the drawing was dimensioned.
+
+// AngleConvention selects how bend angles are entered and displayed.
+type AngleConvention string
+
+const (
+	AngleInternal      AngleConvention = "Internal"      // The included angle between flanges (stored form).
+	AngleExternal      AngleConvention = "External"      // 360° minus the internal angle.
+	AngleComplementary AngleConvention = "Complementary" // Degrees bent from flat: 180° minus internal.
+)
+
+// ToInternalAngle converts a value in the given convention to the internal
+// angle the simulator stores.
+func ToInternalAngle(value float64, conv AngleConvention) (float64, error) {
+	switch conv {
+	case AngleInternal, "":
+		return value, nil
+	case AngleExternal:
+		return 360.0 - value, nil
+	case AngleComplementary:
+		return 180.0 - value, nil
+	default:
+		return 0, fmt.Errorf("unknown angle convention '%s'", conv)
+	}
+}
+
+// FromInternalAngle converts a stored internal angle for display in the
+// given convention.
+func FromInternalAngle(internal float64, conv AngleConvention) float64 {
+	switch conv {
+	case AngleExternal:
+		return 360.0 - internal
+	case AngleComplementary:
+		return 180.0 - internal
+	default:
+		return internal
+	}
+}
+
+// angleSuffix is the unambiguous label appended wherever a converted
+// angle is printed, so a bare number can never be misread.
+func angleSuffix(conv AngleConvention) string {
+	switch conv {
+	case AngleExternal:
+		return "° ext"
+	case AngleComplementary:
+		return "° comp"
+	default:
+		return "° int"
+	}
+}
+
+// FormatAngle renders a stored internal angle in the job's convention
+// with its convention label.
+func FormatAngle(internal float64, conv AngleConvention) string {
+	return fmt.Sprintf("%.1f%s", FromInternalAngle(internal, conv), angleSuffix(conv))
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Annotation pins ---
+// Users drop pins on the profile or flat pattern ("check this dimension",
+// "watch scratch here"). Pins persist with the job and are drawn on
+// exported documents so the shop floor sees the same notes.
+
+// AnnotationView identifies which view a pin belongs to.
+type AnnotationView string
+
+const (
+	AnnotationOnProfile AnnotationView = "Profile"
+	AnnotationOnFlat    AnnotationView = "FlatPattern"
+)
+
+// AnnotationPin is a positioned note on one of the part views.
+type AnnotationPin struct {
+	ID   int            // Stable per-job identifier.
+	View AnnotationView // Which view the pin was placed on.
+	X, Y float64        // Position in part coordinates (mm).
+	Text string         // The note.
+}
+
+// AddAnnotationToCurrentJob drops a new pin on the given view.
+func (jc *JobController) AddAnnotationToCurrentJob(view AnnotationView, x, y float64, text string) (*AnnotationPin, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job to annotate")
+	}
+	if text == "" {
+		return nil, fmt.Errorf("annotation text cannot be empty")
+	}
+	id := 1
+	for _, pin := range jc.currentJob.Annotations {
+		if pin.ID >= id {
+			id = pin.ID + 1
+		}
+	}
+	pin := AnnotationPin{ID: id, View: view, X: x, Y: y, Text: text}
+	jc.currentJob.Annotations = append(jc.currentJob.Annotations, pin)
+	log.Printf("INFO: Annotation pin %d added to job '%s' (%s at %.1f,%.1f): %s",
+		id, jc.currentJob.Name, view, x, y, text)
+	return &pin, nil
+}
+
+// RemoveAnnotationFromCurrentJob deletes the pin with the given ID.
+func (jc *JobController) RemoveAnnotationFromCurrentJob(id int) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to remove annotation from")
+	}
+	for i, pin := range jc.currentJob.Annotations {
+		if pin.ID == id {
+			jc.currentJob.Annotations = append(jc.currentJob.Annotations[:i], jc.currentJob.Annotations[i+1:]...)
+			log.Printf("INFO: Annotation pin %d removed from job '%s'.", id, jc.currentJob.Name)
+			return nil
+		}
+	}
+	return fmt.Errorf("annotation pin %d not found", id)
+}
+
+// svgAnnotationPins renders a job's pins as SVG markers with numbered labels,
+// for inclusion in exported profile documents.
+func svgAnnotationPins(pins []AnnotationPin, view AnnotationView) string {
+	out := ""
+	for _, pin := range pins {
+		if pin.View != view {
+			continue
+		}
+		out += fmt.Sprintf("  <circle cx=\"%.1f\" cy=\"%.1f\" r=\"4\" fill=\"#dc3545\" />\n", pin.X+20, pin.Y+20)
+		out += fmt.Sprintf("  <text x=\"%.1f\" y=\"%.1f\" class=\"info-text\">%d: %s</text>\n", pin.X+27, pin.Y+24, pin.ID, pin.Text)
+	}
+	return out
+}
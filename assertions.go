@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+)
+
+// --- Simulation assertions for automated regression ---
+// Shops want to run their proven job library through CI whenever tool or
+// material data changes. An assertions file states what a job's simulation
+// must satisfy (developed length, tonnage ceiling, no warnings); evaluation
+// returns the failures, and the CLI/server entry points exit non-zero when
+// any assertion fails.
+
+// SimulationAssertions is the JSON schema of an assertions file. Pointer
+// fields are optional: nil means "not asserted".
+type SimulationAssertions struct {
+	DevelopedLengthMM  *float64 `json:"developed_length_mm,omitempty"`
+	DevelopedLengthTol float64  `json:"developed_length_tol_mm,omitempty"` // Defaults to 0.1mm.
+	MaxTonnage         *float64 `json:"max_tonnage,omitempty"`
+	NoWarnings         bool     `json:"no_warnings,omitempty"`
+}
+
+// SimulationSummary captures the measurable outcomes of one simulation run,
+// in the form the assertions evaluate against.
+type SimulationSummary struct {
+	DevelopedLengthMM float64
+	MaxTonnage        float64
+	Warnings          []string
+}
+
+// SummarizeJobSimulation computes a summary for the job as currently
+// programmed: predicted blank length from the deduction estimator, worst
+// per-step tonnage, and the warnings from the feasibility checks.
+func SummarizeJobSimulation(j *Job, pb *PressBrake) (*SimulationSummary, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for simulation summary")
+	}
+	sum := &SimulationSummary{DevelopedLengthMM: j.Sheet.OriginalLength}
+	if dev, devErr := ComputeDevelopedLength(j); devErr == nil {
+		sum.DevelopedLengthMM = dev.FlatLengthMM - dev.TotalDeduction
+	}
+
+	var die *Die
+	if pb != nil {
+		die = pb.GetCurrentDie()
+	}
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		if die != nil && j.Sheet.Width > 0 {
+			tonnes := AirBendForcePerMeter(j.Sheet, die) * (j.Sheet.Width / 1000.0) / 9.81
+			if tonnes > sum.MaxTonnage {
+				sum.MaxTonnage = tonnes
+			}
+		}
+	}
+	if pb != nil {
+		if fitWarnings, err := CheckThroatFeasibility(j, pb); err == nil {
+			sum.Warnings = append(sum.Warnings, fitWarnings...)
+		}
+		if accWarnings, err := CheckAccessoryInterference(j, pb); err == nil {
+			sum.Warnings = append(sum.Warnings, accWarnings...)
+		}
+		if tonWarnings, err := CheckJobTonnage(j, pb); err == nil {
+			sum.Warnings = append(sum.Warnings, tonWarnings...)
+		}
+	}
+	return sum, nil
+}
+
+// LoadAssertionsFile reads and parses a JSON assertions file.
+func LoadAssertionsFile(path string) (*SimulationAssertions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading assertions file: %w", err)
+	}
+	sa := &SimulationAssertions{}
+	if err := json.Unmarshal(data, sa); err != nil {
+		return nil, fmt.Errorf("decoding assertions file '%s': %w", path, err)
+	}
+	if sa.DevelopedLengthTol <= 0 {
+		sa.DevelopedLengthTol = 0.1
+	}
+	return sa, nil
+}
+
+// Evaluate checks the summary against the assertions, returning one message
+// per failed assertion (empty means all passed).
+func (sa *SimulationAssertions) Evaluate(sum *SimulationSummary) []string {
+	var failures []string
+	if sum == nil {
+		return []string{"no simulation summary available"}
+	}
+	if sa.DevelopedLengthMM != nil {
+		diff := sum.DevelopedLengthMM - *sa.DevelopedLengthMM
+		if diff < -sa.DevelopedLengthTol || diff > sa.DevelopedLengthTol {
+			failures = append(failures, fmt.Sprintf("developed length %.3fmm differs from expected %.3fmm (tol %.3fmm)",
+				sum.DevelopedLengthMM, *sa.DevelopedLengthMM, sa.DevelopedLengthTol))
+		}
+	}
+	if sa.MaxTonnage != nil && sum.MaxTonnage > *sa.MaxTonnage {
+		failures = append(failures, fmt.Sprintf("max tonnage %.2ft exceeds asserted limit %.2ft", sum.MaxTonnage, *sa.MaxTonnage))
+	}
+	if sa.NoWarnings && len(sum.Warnings) > 0 {
+		failures = append(failures, fmt.Sprintf("%d warning(s) present, none allowed: %s", len(sum.Warnings), sum.Warnings[0]))
+	}
+	return failures
+}
+
+// RunAssertions simulates the job, evaluates the assertions file against it,
+// and returns the process exit code CI should see (0 pass, 1 fail). Intended
+// for the CLI/server entry points.
+func RunAssertions(assertPath string, j *Job, pb *PressBrake) int {
+	sa, err := LoadAssertionsFile(assertPath)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return 1
+	}
+	sum, err := SummarizeJobSimulation(j, pb)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return 1
+	}
+	failures := sa.Evaluate(sum)
+	for _, f := range failures {
+		log.Printf("ASSERTION FAILED: %s", f)
+	}
+	if len(failures) > 0 {
+		return 1
+	}
+	log.Printf("INFO: All assertions in '%s' passed for job '%s'.", assertPath, j.Name)
+	return 0
+}
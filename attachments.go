@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// --- Job notes and attachments ---
+// Programmers work against a customer drawing; keeping it (plus free-form
+// notes) attached to the job means no switching to an external viewer while
+// entering bends. Image attachments display in the profile panel; PDFs are
+// tracked and listed but rendered externally until a PDF rasterizer lands.
+
+// AttachmentKind classifies an attachment by how it can be displayed.
+type AttachmentKind string
+
+const (
+	AttachmentImage AttachmentKind = "Image" // PNG/JPEG, viewable in-app.
+	AttachmentPDF   AttachmentKind = "PDF"   // Tracked, opened externally.
+	AttachmentOther AttachmentKind = "Other"
+)
+
+// JobAttachment is a file (drawing, photo, certificate) linked to a job.
+type JobAttachment struct {
+	Name string         // Display name (defaults to the file base name).
+	Path string         // Absolute path to the file on disk.
+	Kind AttachmentKind // How the file can be displayed.
+}
+
+// attachmentKindForPath infers the attachment kind from the file extension.
+func attachmentKindForPath(path string) AttachmentKind {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png", ".jpg", ".jpeg":
+		return AttachmentImage
+	case ".pdf":
+		return AttachmentPDF
+	default:
+		return AttachmentOther
+	}
+}
+
+// AddAttachmentToCurrentJob links a file on disk to the current job.
+func (jc *JobController) AddAttachmentToCurrentJob(path string) (*JobAttachment, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job to attach to")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("attachment file not accessible: %w", err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("attachment path '%s' is a directory", path)
+	}
+	att := JobAttachment{
+		Name: filepath.Base(path),
+		Path: path,
+		Kind: attachmentKindForPath(path),
+	}
+	jc.currentJob.Attachments = append(jc.currentJob.Attachments, att)
+	log.Printf("INFO: Attached '%s' (%s) to job '%s'.", att.Name, att.Kind, jc.currentJob.Name)
+	return &att, nil
+}
+
+// SetJobNotes replaces the free-form notes on the current job.
+func (jc *JobController) SetJobNotes(notes string) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to set notes on")
+	}
+	jc.currentJob.Notes = notes
+	return nil
+}
+
+// viewAttachment shows an attachment: images load into the profile display
+// panel, other kinds just report where the file lives.
+func (ac *AppController) viewAttachment(att *JobAttachment) {
+	if att == nil {
+		ac.updateStatus("No attachment selected.", true)
+		return
+	}
+	switch att.Kind {
+	case AttachmentImage:
+		ac.displayProfileImage(att.Path)
+	case AttachmentPDF:
+		ac.updateStatus(fmt.Sprintf("PDF attachment '%s' at: %s (open externally).", att.Name, att.Path), false)
+	default:
+		ac.updateStatus(fmt.Sprintf("Attachment '%s' at: %s", att.Name, att.Path), false)
+	}
+}
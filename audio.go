@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+)
+
+// --- Audio cues ---
+// Users rarely stare at the status line; a short sound for stroke
+// completion, collisions and over-tonnage alarms catches failures while
+// they look elsewhere. Output goes through a pluggable backend so a real
+// sound device can be wired in per platform; the built-in fallback is the
+// terminal bell, which at least works everywhere.
+
+// SoundCue identifies one audible event.
+type SoundCue string
+
+const (
+	CueStrokeComplete SoundCue = "StrokeComplete"
+	CueCollision      SoundCue = "CollisionWarning"
+	CueOverTonnage    SoundCue = "OverTonnageAlarm"
+	CueWarning        SoundCue = "Warning" // Generic validation failure.
+)
+
+// AudioBackend plays cues on an actual sound device.
+type AudioBackend interface {
+	Play(cue SoundCue, volume float64) error
+}
+
+// AudioSettings is the user's audio preference.
+type AudioSettings struct {
+	Muted  bool
+	Volume float64 // 0.0 - 1.0.
+}
+
+// audioState holds the active settings and backend.
+var audioState = struct {
+	mu       sync.Mutex
+	settings AudioSettings
+	backend  AudioBackend
+}{settings: AudioSettings{Volume: 0.8}}
+
+// SetAudioSettings updates the volume/mute preference.
+func SetAudioSettings(s AudioSettings) {
+	if s.Volume < 0 {
+		s.Volume = 0
+	}
+	if s.Volume > 1 {
+		s.Volume = 1
+	}
+	audioState.mu.Lock()
+	audioState.settings = s
+	audioState.mu.Unlock()
+	log.Printf("INFO: Audio settings: muted=%v, volume %.0f%%.", s.Muted, s.Volume*100)
+}
+
+// SetAudioBackend installs a platform audio backend. Passing nil reverts to
+// the terminal-bell fallback.
+func SetAudioBackend(b AudioBackend) {
+	audioState.mu.Lock()
+	audioState.backend = b
+	audioState.mu.Unlock()
+}
+
+// PlayCue plays a cue respecting the mute/volume preference. Failures are
+// logged, never fatal: audio is a convenience, not a dependency.
+func PlayCue(cue SoundCue) {
+	audioState.mu.Lock()
+	settings := audioState.settings
+	backend := audioState.backend
+	audioState.mu.Unlock()
+
+	if settings.Muted || settings.Volume <= 0 {
+		return
+	}
+	if backend != nil {
+		if err := backend.Play(cue, settings.Volume); err != nil {
+			log.Printf("WARNING: Audio backend failed for cue '%s': %v", cue, err)
+		}
+		return
+	}
+	// Fallback: terminal bell. Alarms get a double ring.
+	if cue == CueOverTonnage || cue == CueCollision {
+		fmt.Fprint(os.Stderr, "\a\a")
+	} else {
+		fmt.Fprint(os.Stderr, "\a")
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// --- Bend direction line styles ---
+// Drafting convention tells the two bend senses apart at a glance: bends
+// toward the viewer (up) draw solid, bends away (down) draw dashed, each
+// with its own color. The styles are a preference like the export render
+// profile and every drawing that marks bend lines — the flat pattern SVG
+// here, DXF and friends — pulls them from one place.
+
+// BendLineStyle is how one bend sense is drawn.
+type BendLineStyle struct {
+	Color  string // Stroke color.
+	Dashed bool
+}
+
+// BendDirectionStyles pairs the styles for both senses.
+type BendDirectionStyles struct {
+	Up   BendLineStyle
+	Down BendLineStyle
+}
+
+// defaultBendDirectionStyles follows the common convention: up solid
+// green, down dashed red.
+var defaultBendDirectionStyles = BendDirectionStyles{
+	Up:   BendLineStyle{Color: "#1a7e3c", Dashed: false},
+	Down: BendLineStyle{Color: "#c0392b", Dashed: true},
+}
+
+var (
+	bendStyleMu         sync.RWMutex
+	activeBendDirStyles = defaultBendDirectionStyles
+)
+
+// SetBendDirectionStyles selects the styles used for subsequent drawings.
+func SetBendDirectionStyles(s BendDirectionStyles) {
+	bendStyleMu.Lock()
+	activeBendDirStyles = s
+	bendStyleMu.Unlock()
+	log.Printf("INFO: Bend direction styles set (up %s, down %s).", s.Up.Color, s.Down.Color)
+}
+
+// GetBendDirectionStyles returns the styles used for drawings.
+func GetBendDirectionStyles() BendDirectionStyles {
+	bendStyleMu.RLock()
+	defer bendStyleMu.RUnlock()
+	return activeBendDirStyles
+}
+
+// styleForDirection picks the style for one step's bend sense.
+func styleForDirection(dir BendDirection) BendLineStyle {
+	s := GetBendDirectionStyles()
+	if dir == BendDirectionDown {
+		return s.Down
+	}
+	return s.Up
+}
+
+// svgDashAttr renders the dash attribute for a style ("" when solid).
+func svgDashAttr(style BendLineStyle) string {
+	if !style.Dashed {
+		return ""
+	}
+	return ` stroke-dasharray="6 4"`
+}
+
+// GenerateFlatPatternSVG draws the developed blank with every enabled bend
+// line at its flat position, styled by direction, and the angle beside it.
+func GenerateFlatPatternSVG(j *Job, filePath string) error {
+	if j == nil || j.Sheet == nil {
+		return fmt.Errorf("job or sheet is nil for flat pattern SVG")
+	}
+	sheet := j.Sheet
+
+	const pad = 20.0
+	svgWidth := sheet.OriginalLength + 2*pad
+	svgHeight := sheet.Width + 2*pad + 30
+
+	rp := GetExportRenderProfile()
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<svg width=\"%.1f\" height=\"%.1f\" xmlns=\"http://www.w3.org/2000/svg\" style=\"background-color: %s; font-family: sans-serif;\">\n", svgWidth, svgHeight, rp.Background))
+	sb.WriteString(fmt.Sprintf("  <title>Flat pattern: %s</title>\n", j.Name))
+	sb.WriteString(fmt.Sprintf("  <rect x=\"%.1f\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" fill=\"%s\" stroke=\"%s\" stroke-width=\"%.1f\" />\n",
+		pad, pad, sheet.OriginalLength, sheet.Width, rp.SheetFill, rp.OutlineColor, rp.OutlineWeight))
+
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		style := styleForDirection(step.Direction)
+		x := pad + step.Position
+		sb.WriteString(fmt.Sprintf("  <line x1=\"%.1f\" y1=\"%.1f\" x2=\"%.1f\" y2=\"%.1f\" stroke=\"%s\" stroke-width=\"%.1f\"%s />\n",
+			x, pad, x, pad+sheet.Width, style.Color, rp.DetailWeight, svgDashAttr(style)))
+		sb.WriteString(fmt.Sprintf("  <text x=\"%.1f\" y=\"%.1f\" font-size=\"9\" fill=\"%s\">%s° %s</text>\n",
+			x+2, pad+sheet.Width+12, style.Color, FormatExportAngle(step.TargetAngle), step.Direction))
+	}
+
+	sb.WriteString(svgReferenceEdgeMarker(j.ReferenceFlipped, sheet.OriginalLength, pad, pad+sheet.Width))
+	sb.WriteString(svgAnnotationPins(j.Annotations, AnnotationOnFlat))
+	sb.WriteString("</svg>\n")
+
+	if err := os.WriteFile(filePath, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("writing flat pattern SVG '%s': %w", filePath, err)
+	}
+	log.Printf("INFO: Flat pattern SVG for job '%s' written to '%s'.", j.Name, filePath)
+	return nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// --- Cycle-time calibration wizard ---
+// Estimates are only as good as the model parameters. The wizard has the
+// user time a few reference operations on their machine (strokes, gauge
+// moves, part flips), averages the samples per operation, and stores the
+// fitted parameters in the machine profile.
+
+// Calibration operation identifiers.
+const (
+	CalOpStroke    = "stroke"
+	CalOpGaugeMove = "gauge"
+	CalOpFlip      = "flip"
+)
+
+// CalibrationSample is one timed reference operation.
+type CalibrationSample struct {
+	Operation string
+	Duration  time.Duration
+}
+
+// CalibrationWizard collects timed samples and fits a cycle-time model.
+type CalibrationWizard struct {
+	samples []CalibrationSample
+	running string    // Operation currently being timed, "" when idle.
+	started time.Time // When the running sample began.
+}
+
+// NewCalibrationWizard returns an empty wizard session.
+func NewCalibrationWizard() *CalibrationWizard { return &CalibrationWizard{} }
+
+// Begin starts timing one reference operation.
+func (cw *CalibrationWizard) Begin(operation string) error {
+	switch operation {
+	case CalOpStroke, CalOpGaugeMove, CalOpFlip:
+	default:
+		return fmt.Errorf("unknown calibration operation '%s'", operation)
+	}
+	if cw.running != "" {
+		return fmt.Errorf("already timing '%s'; finish it first", cw.running)
+	}
+	cw.running = operation
+	cw.started = time.Now()
+	return nil
+}
+
+// Finish stops the running sample and stores it.
+func (cw *CalibrationWizard) Finish() (*CalibrationSample, error) {
+	if cw.running == "" {
+		return nil, fmt.Errorf("no calibration operation is being timed")
+	}
+	sample := CalibrationSample{Operation: cw.running, Duration: time.Since(cw.started)}
+	cw.samples = append(cw.samples, sample)
+	cw.running = ""
+	log.Printf("INFO: Calibration sample recorded: %s %.2fs (%d total).", sample.Operation, sample.Duration.Seconds(), len(cw.samples))
+	return &sample, nil
+}
+
+// SampleCount returns how many samples exist for the given operation.
+func (cw *CalibrationWizard) SampleCount(operation string) int {
+	n := 0
+	for _, s := range cw.samples {
+		if s.Operation == operation {
+			n++
+		}
+	}
+	return n
+}
+
+// Fit averages the collected samples into a cycle-time model. Operations
+// with no samples keep their value from the base model.
+func (cw *CalibrationWizard) Fit(base CycleTimeModel) (CycleTimeModel, error) {
+	if len(cw.samples) == 0 {
+		return base, fmt.Errorf("no calibration samples collected")
+	}
+	sums := map[string]float64{}
+	counts := map[string]int{}
+	for _, s := range cw.samples {
+		sums[s.Operation] += s.Duration.Seconds()
+		counts[s.Operation]++
+	}
+	fitted := base
+	if n := counts[CalOpStroke]; n > 0 {
+		fitted.StrokeSec = sums[CalOpStroke] / float64(n)
+	}
+	if n := counts[CalOpGaugeMove]; n > 0 {
+		fitted.GaugeMoveSec = sums[CalOpGaugeMove] / float64(n)
+	}
+	if n := counts[CalOpFlip]; n > 0 {
+		fitted.FlipSec = sums[CalOpFlip] / float64(n)
+	}
+	return fitted, nil
+}
+
+// ApplyToMachine fits the model and stores it on the machine profile.
+func (cw *CalibrationWizard) ApplyToMachine(pb *PressBrake) error {
+	if pb == nil {
+		return fmt.Errorf("no machine to calibrate")
+	}
+	fitted, err := cw.Fit(pb.CycleTimes)
+	if err != nil {
+		return err
+	}
+	pb.CycleTimes = fitted
+	log.Printf("INFO: PressBrake '%s' cycle-time model calibrated: stroke %.2fs, gauge %.2fs, flip %.2fs.",
+		pb.Name, fitted.StrokeSec, fitted.GaugeMoveSec, fitted.FlipSec)
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Punch clamping system compatibility ---
+// Punches only physically mount on machines with a matching clamping system
+// (or via an adapter). Machines and punches now declare their clamping type
+// and tool selection refuses incompatible combinations up front instead of
+// letting the user program a setup that cannot be built.
+
+// ClampingType identifies a punch clamping/mounting system.
+type ClampingType string
+
+const (
+	ClampingEuropean    ClampingType = "European"     // Promecam/European style tang.
+	ClampingAmerican    ClampingType = "American"     // American planer style.
+	ClampingNewStandard ClampingType = "New Standard" // Trumpf-style new standard.
+	ClampingWila        ClampingType = "Wila"         // Wila/Trumpf hydraulic style.
+)
+
+// ClampingAdapter allows mounting tools of one clamping type on a machine
+// with a different clamping system.
+type ClampingAdapter struct {
+	Name     string
+	Machine  ClampingType // Clamping system of the machine side.
+	Tool     ClampingType // Clamping system the adapter accepts.
+	HeightMM float64      // Extra stack height the adapter introduces.
+}
+
+// CanMountPunch reports whether the punch can be mounted on this machine,
+// either directly or through one of the installed adapters. The returned
+// string names the adapter used, or is empty for a direct fit.
+func (pb *PressBrake) CanMountPunch(p *Punch) (bool, string) {
+	if p == nil {
+		return false, ""
+	}
+	// Unspecified clamping (legacy data) is treated as universal.
+	if pb.Clamping == "" || p.Clamping == "" || pb.Clamping == p.Clamping {
+		return true, ""
+	}
+	for _, ad := range pb.Adapters {
+		if ad.Machine == pb.Clamping && ad.Tool == p.Clamping {
+			return true, ad.Name
+		}
+	}
+	return false, ""
+}
+
+// checkPunchClamping returns a descriptive error when the punch cannot be
+// mounted on the machine's clamping system.
+func (pb *PressBrake) checkPunchClamping(p *Punch) error {
+	ok, adapter := pb.CanMountPunch(p)
+	if !ok {
+		return fmt.Errorf("punch '%s' (%s clamping) is incompatible with machine '%s' (%s clamping) and no adapter is installed",
+			p.Name, p.Clamping, pb.Name, pb.Clamping)
+	}
+	if adapter != "" {
+		log.Printf("INFO: Punch '%s' mounts on '%s' via adapter '%s'.", p.Name, pb.Name, adapter)
+	}
+	return nil
+}
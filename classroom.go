@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// --- Classroom session server ---
+// In classroom mode one machine runs a small HTTP server; student clients
+// submit their exercise results and the instructor watches a live
+// leaderboard plus per-student mistakes. Everything stays on the local
+// network; there is no external service involved.
+
+// ExerciseResult is one student's submitted attempt at an exercise.
+type ExerciseResult struct {
+	Student     string    `json:"student"`
+	Exercise    string    `json:"exercise"`
+	Score       float64   `json:"score"`
+	DurationSec float64   `json:"duration_sec"`
+	Mistakes    []string  `json:"mistakes,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// LeaderboardEntry is a row of the aggregated leaderboard.
+type LeaderboardEntry struct {
+	Student   string  `json:"student"`
+	BestScore float64 `json:"best_score"`
+	Attempts  int     `json:"attempts"`
+}
+
+// ClassroomServer collects exercise results and serves the leaderboard.
+type ClassroomServer struct {
+	mu      sync.RWMutex
+	results []ExerciseResult
+	server  *http.Server
+}
+
+// NewClassroomServer returns an idle classroom server.
+func NewClassroomServer() *ClassroomServer { return &ClassroomServer{} }
+
+// Start begins listening on the given address (e.g. ":8090"). Endpoints:
+// POST /submit takes an ExerciseResult; GET /leaderboard returns the
+// aggregated standings; GET /mistakes?student=NAME lists that student's
+// recorded mistakes.
+func (cs *ClassroomServer) Start(addr string) error {
+	if cs.server != nil {
+		return fmt.Errorf("classroom server already running on %s", cs.server.Addr)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/submit", cs.handleSubmit)
+	mux.HandleFunc("/leaderboard", cs.handleLeaderboard)
+	mux.HandleFunc("/mistakes", cs.handleMistakes)
+
+	cs.server = &http.Server{Addr: addr, Handler: mux, ReadTimeout: 10 * time.Second, WriteTimeout: 10 * time.Second}
+	go func() {
+		log.Printf("INFO: Classroom server listening on %s.", addr)
+		if err := cs.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("ERROR: Classroom server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop shuts the server down.
+func (cs *ClassroomServer) Stop() error {
+	if cs.server == nil {
+		return fmt.Errorf("classroom server is not running")
+	}
+	err := cs.server.Close()
+	cs.server = nil
+	log.Println("INFO: Classroom server stopped.")
+	return err
+}
+
+func (cs *ClassroomServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST required", http.StatusMethodNotAllowed)
+		return
+	}
+	var res ExerciseResult
+	if err := json.NewDecoder(r.Body).Decode(&res); err != nil {
+		http.Error(w, fmt.Sprintf("bad result payload: %v", err), http.StatusBadRequest)
+		return
+	}
+	if res.Student == "" || res.Exercise == "" {
+		http.Error(w, "student and exercise are required", http.StatusBadRequest)
+		return
+	}
+	res.SubmittedAt = time.Now()
+	cs.mu.Lock()
+	cs.results = append(cs.results, res)
+	cs.mu.Unlock()
+	log.Printf("INFO: Classroom: %s submitted '%s' (score %.1f, %d mistake(s)).",
+		res.Student, res.Exercise, res.Score, len(res.Mistakes))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (cs *ClassroomServer) handleLeaderboard(w http.ResponseWriter, r *http.Request) {
+	cs.mu.RLock()
+	byStudent := make(map[string]*LeaderboardEntry)
+	for _, res := range cs.results {
+		entry, ok := byStudent[res.Student]
+		if !ok {
+			entry = &LeaderboardEntry{Student: res.Student}
+			byStudent[res.Student] = entry
+		}
+		entry.Attempts++
+		if res.Score > entry.BestScore {
+			entry.BestScore = res.Score
+		}
+	}
+	cs.mu.RUnlock()
+
+	board := make([]LeaderboardEntry, 0, len(byStudent))
+	for _, entry := range byStudent {
+		board = append(board, *entry)
+	}
+	sort.Slice(board, func(i, j int) bool { return board[i].BestScore > board[j].BestScore })
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(board); err != nil {
+		log.Printf("ERROR: Encoding leaderboard: %v", err)
+	}
+}
+
+func (cs *ClassroomServer) handleMistakes(w http.ResponseWriter, r *http.Request) {
+	student := r.URL.Query().Get("student")
+	if student == "" {
+		http.Error(w, "student query parameter required", http.StatusBadRequest)
+		return
+	}
+	cs.mu.RLock()
+	mistakes := make(map[string][]string) // exercise -> mistakes
+	for _, res := range cs.results {
+		if res.Student == student && len(res.Mistakes) > 0 {
+			mistakes[res.Exercise] = append(mistakes[res.Exercise], res.Mistakes...)
+		}
+	}
+	cs.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(mistakes); err != nil {
+		log.Printf("ERROR: Encoding mistakes for '%s': %v", student, err)
+	}
+}
+
+// ScoreAttempt computes a weighted score (0-100) for an exercise attempt
+// from its measured criteria, using the exercise's scoring weights.
+func ScoreAttempt(ex *Exercise, flips int, collisionWarnings int, durationSec float64) float64 {
+	if ex == nil {
+		return 0
+	}
+	w := ex.Weights
+	total := w.SequenceEfficiency + w.CollisionsAvoided + w.Time
+	if total <= 0 {
+		return 0
+	}
+
+	seqScore := 1.0 / (1.0 + float64(flips))
+	colScore := 0.0
+	if collisionWarnings == 0 {
+		colScore = 1.0
+	}
+	timeScore := 1.0
+	if ex.TimeLimitSec > 0 && durationSec > 0 {
+		timeScore = ex.TimeLimitSec / (ex.TimeLimitSec + durationSec)
+	}
+	return 100 * (w.SequenceEfficiency*seqScore + w.CollisionsAvoided*colScore + w.Time*timeScore) / total
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"image"
 	"image/color"
@@ -10,9 +11,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	// Standard Gio imports.
@@ -20,6 +19,7 @@ import (
 	"gioui.org/app"
 	"gioui.org/font/gofont"
 	"gioui.org/io/key"
+	"gioui.org/io/pointer"
 	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op"
@@ -40,7 +40,7 @@ const (
 	minBendRadius = 0.0 // Minimum bend radius (0 can mean sharp, though practically limited by material)
 	maxBendRadius = 500.0 // Sensible upper limit for bend radius
 	minBendAngle = 1.0 // Min bend angle (exclusive 0)
-	maxBendAngle = 179.0 // Max bend angle (exclusive 180)
+	maxBendAngle = 179.0 // Max conventional bend angle; the flattening band extends to 180 (see flattening.go)
 )
 
 
@@ -63,10 +63,13 @@ const (
 // MaterialDetails holds properties of a specific material.
 type MaterialDetails struct {
 	Name                MaterialName
+	Temper              string  // Optional temper designation, e.g. "H32", "H34".
 	Density             float64 // kg/m^3
 	YieldStress         float64 // MPa
 	TensileModulus      float64 // GPa (Young's Modulus)
 	MinBendRadiusFactor float64 // Factor times thickness for MINIMUM recommended bend radius.
+	MinRadius           *MinRadiusSpec // Optional per-orientation factors; overrides MinBendRadiusFactor when set.
+	FrictionFactor      float64        // Force multiplier for surface condition (0 = default 1.0); <1 for oiled/coated sheet.
 }
 
 // SheetMetal represents the workpiece.
@@ -76,7 +79,9 @@ type SheetMetal struct {
 	Thickness      float64 // mm
 	Width          float64 // mm
 	Material       MaterialDetails
-	CurrentBends   []BendStep // Represents the formed state of the sheet.
+	Cosmetic       bool              // Visible/cosmetic surface; marking scraps the part.
+	Protection     SurfaceProtection // Film or urethane protection in use.
+	CurrentBends   []BendStep        // Represents the formed state of the sheet.
 }
 
 // NewSheetMetal creates a new sheet metal object.
@@ -94,6 +99,7 @@ func NewSheetMetal(id string, length, width, thickness float64, material Materia
 		Width:          width,
 		Thickness:      thickness,
 		Material:       material,
+		Protection:     ProtectionNone,
 		CurrentBends:   make([]BendStep, 0),
 	}, nil
 }
@@ -119,7 +125,7 @@ func (s *SheetMetal) GetMinBendRadius() float64 {
 // In a real app, this might be loaded from a config file or database.
 var defaultMaterials = map[MaterialName]MaterialDetails{
 	SteelMaterial:     {Name: SteelMaterial, Density: 7850, YieldStress: 250, TensileModulus: 200, MinBendRadiusFactor: 1.5},
-	AluminumMaterial:  {Name: AluminumMaterial, Density: 2700, YieldStress: 100, TensileModulus: 70, MinBendRadiusFactor: 1.0},
+	AluminumMaterial:  {Name: AluminumMaterial, Temper: "H32", Density: 2700, YieldStress: 100, TensileModulus: 70, MinBendRadiusFactor: 1.0, MinRadius: &MinRadiusSpec{Transverse: 1.0, Longitudinal: 1.5}},
 	StainlessMaterial: {Name: StainlessMaterial, Density: 8000, YieldStress: 215, TensileModulus: 193, MinBendRadiusFactor: 2.0},
 	CopperMaterial:    {Name: CopperMaterial, Density: 8960, YieldStress: 70, TensileModulus: 117, MinBendRadiusFactor: 0.8},
 	MildSteelMaterial: {Name: MildSteelMaterial, Density: 7850, YieldStress: 220, TensileModulus: 200, MinBendRadiusFactor: 1.2},
@@ -152,10 +158,12 @@ func GetMaterialNames(mats map[MaterialName]MaterialDetails) []string {
 
 // Punch represents the upper tool of the press brake.
 type Punch struct {
-	Name   string
-	Height float64 // mm
-	Angle  float64 // degrees, e.g., 88, 90, 30
-	Radius float64 // mm, tip radius of the punch
+	Name          string
+	Height        float64      // mm
+	Angle         float64      // degrees, e.g., 88, 90, 30
+	Radius        float64      // mm, tip radius of the punch
+	MaxLoadKNPerM float64      // kN/m, maximum rated load per meter of tool length
+	Clamping      ClampingType // Clamping system the punch tang fits.
 }
 
 // Die represents the lower tool (V-die) of the press brake.
@@ -164,6 +172,8 @@ type Die struct {
 	VOpening       float64 // mm, width of the V-opening
 	Angle          float64 // degrees, angle of the V
 	ShoulderRadius float64 // mm, radius of the die shoulders
+	MaxLoadKNPerM  float64 // kN/m, maximum rated load per meter of tool length
+	Finish         DieFinish // Shoulder surface finish (empty = Standard).
 }
 
 // ToolingManager manages the available punches and dies.
@@ -177,14 +187,14 @@ func NewToolingManager() *ToolingManager {
 	// In a real app, this data would likely be loaded from a configuration file or database.
 	return &ToolingManager{
 		punches: map[string]*Punch{
-			"P88.10.R06":    {Name: "P88.10.R06", Height: 60, Angle: 88, Radius: 0.6},
-			"P30.15.R1":     {Name: "P30.15.R1", Height: 65, Angle: 30, Radius: 1.0},
-			"Default Punch": {Name: "Default Punch", Height: 50, Angle: 90, Radius: 1.0},
+			"P88.10.R06":    {Name: "P88.10.R06", Height: 60, Angle: 88, Radius: 0.6, MaxLoadKNPerM: 1000, Clamping: ClampingEuropean},
+			"P30.15.R1":     {Name: "P30.15.R1", Height: 65, Angle: 30, Radius: 1.0, MaxLoadKNPerM: 400, Clamping: ClampingEuropean},
+			"Default Punch": {Name: "Default Punch", Height: 50, Angle: 90, Radius: 1.0, MaxLoadKNPerM: 800, Clamping: ClampingEuropean},
 		},
 		dies: map[string]*Die{
-			"D12.90.R2":   {Name: "D12.90.R2", VOpening: 12, Angle: 90, ShoulderRadius: 2.0},
-			"D20.60.R3":   {Name: "D20.60.R3", VOpening: 20, Angle: 60, ShoulderRadius: 3.0},
-			"Default Die": {Name: "Default Die", VOpening: 16, Angle: 90, ShoulderRadius: 2.0},
+			"D12.90.R2":   {Name: "D12.90.R2", VOpening: 12, Angle: 90, ShoulderRadius: 2.0, MaxLoadKNPerM: 1000},
+			"D20.60.R3":   {Name: "D20.60.R3", VOpening: 20, Angle: 60, ShoulderRadius: 3.0, MaxLoadKNPerM: 1200},
+			"Default Die": {Name: "Default Die", VOpening: 16, Angle: 90, ShoulderRadius: 2.0, MaxLoadKNPerM: 800},
 		},
 	}
 }
@@ -230,13 +240,37 @@ type BendStep struct {
 	TargetAngle   float64       // Desired internal angle of the bend (degrees).
 	Radius        float64       // Desired inner bend radius (mm).
 	Direction     BendDirection // Direction of the bend.
+	Station       string        // Optional tool station assignment (e.g. generated hem steps).
+	StationOffsetMM float64     // Offset of the sheet center from the ram centerline (mm, + = right).
+	EndAngle      *float64      // Target angle at the Y2 end for conical bends; nil = straight bend.
+	Disabled      bool          // Skipped in simulation and exports but kept in the job.
+	Operation     string        // Named operation this step belongs to ("" = default op).
+	AllowanceOffsetMM float64   // Deliberate gauge offset (e.g. +0.2 for weld gap); see gauge.go.
+	OffsetReason  string        // Why the offset exists ("paint", "weld gap", ...).
+	GaugeRetract  bool          // Back gauge retracts during the stroke; see gaugeopts.go.
+	PinchConfirm  bool          // Stroke pauses at the pinch point for confirmation.
 }
 
 // Job represents a set of operations to be performed on a sheet metal.
 type Job struct {
-	Name  string
-	Sheet *SheetMetal // The workpiece for this job.
-	Steps []*BendStep // The sequence of bend operations.
+	Name        string
+	Customer    string          // Optional customer this job belongs to (tolerance lookup).
+	OrderID     string          // Optional ERP work order this job fulfils.
+	Sheet       *SheetMetal     // The workpiece for this job.
+	Steps       []*BendStep     // The sequence of bend operations.
+	Overrides   []RuleOverride  // Warning overrides accepted for this job.
+	Notes       string          // Free-form programmer notes.
+	Attachments []JobAttachment // Drawings and other files linked to this job.
+	Annotations []AnnotationPin // Positioned notes on the part views.
+	TimeRecords []TimeRecord    // Actual production times reported for this job.
+	Tags        []string        // Lowercase free-form labels for the job browser.
+	ReferenceFlipped bool       // Positions measured from the far edge instead of the default.
+	SimHistory  []SimulationRecord // Summaries of past simulation runs, oldest first.
+	AngleConv   AngleConvention // How angles are entered/displayed ("" = internal).
+	StockCert   *StockCertificate // Raw material traceability for this run, if recorded.
+	ToolLock    *ToolingLock    // Frozen tool selection after acceptance; nil = unlocked.
+	LimitExceptions []LimitException // Approved deviations from global limits; see limitexceptions.go.
+	Corrections GlobalCorrections // Export-only position/angle tuning; see corrections.go.
 }
 
 // NewJob creates a new job with a given name and sheet.
@@ -253,6 +287,8 @@ func NewJob(name string, sheet *SheetMetal) (*Job, error) {
 // JobController manages job-related operations.
 type JobController struct {
 	currentJob *Job
+	valCache   validationCache // Per-step validation results for incremental revalidation.
+	timerStart time.Time       // Start of the running job timer; zero when idle.
 }
 
 func NewJobController() *JobController { return &JobController{} }
@@ -266,14 +302,8 @@ func (jc *JobController) AddBendStepToCurrentJob(pos, angle, radius float64, dir
 	if jc.currentJob.Sheet == nil { return nil, fmt.Errorf("current job has no sheet defined") }
 
 	// Parameter validation
-	if pos <= 0 || pos >= jc.currentJob.Sheet.OriginalLength {
-		return nil, fmt.Errorf("bend position (%.2fmm) is outside sheet length (0-%.2fmm)", pos, jc.currentJob.Sheet.OriginalLength)
-	}
-	if radius < minBendRadius || radius > maxBendRadius {
-		return nil, fmt.Errorf("bend radius (%.2fmm) is outside allowed range (%.2f-%.2fmm)", radius, minBendRadius, maxBendRadius)
-	}
-	if angle < minBendAngle || angle > maxBendAngle {
-		return nil, fmt.Errorf("bend angle (%.2f°) is outside allowed range (%.1f-%.1f°)", angle, minBendAngle, maxBendAngle)
+	if err := validateBendStepParams(jc.currentJob.Sheet, pos, angle, radius); err != nil {
+		return nil, err
 	}
 
 	step := &BendStep{
@@ -284,6 +314,7 @@ func (jc *JobController) AddBendStepToCurrentJob(pos, angle, radius float64, dir
 		Direction:     dir,
 	}
 	jc.currentJob.Steps = append(jc.currentJob.Steps, step)
+	jc.InvalidateStepsFrom(len(jc.currentJob.Steps) - 1) // Only the new step needs validating.
 	log.Printf("INFO: Added bend step %d to job '%s': Pos:%.1f, Ang:%.1f, Rad:%.1f, Dir:%s",
 		step.SequenceOrder, pos, angle, radius, dir, jc.currentJob.Name)
 	return step, nil
@@ -293,6 +324,7 @@ func (jc *JobController) AddBendStepToCurrentJob(pos, angle, radius float64, dir
 func (jc *JobController) ClearBendStepsFromCurrentJob() error {
 	if jc.currentJob == nil { return fmt.Errorf("no current job to clear steps from") }
 	jc.currentJob.Steps = make([]*BendStep, 0)
+	jc.valCache.reset()
 	if jc.currentJob.Sheet != nil {
 		jc.currentJob.Sheet.ResetForm() // Reset sheet to flat state
 	}
@@ -303,17 +335,32 @@ func (jc *JobController) ClearBendStepsFromCurrentJob() error {
 // PressBrake represents the (simulated) CNC machine.
 type PressBrake struct {
 	Name                  string
+	MaxTonnage            float64           // Rated capacity of the machine in tonnes.
+	ThroatDepth           float64           // mm, gap depth between ram centerline and frame.
+	OpenHeight            float64           // mm, daylight between punch tip (raised) and die.
+	Clamping              ClampingType      // Punch clamping system fitted to the ram.
+	Adapters              []ClampingAdapter // Installed clamping adapters, if any.
+	CycleTimes            CycleTimeModel    // Calibrated per-operation time parameters.
+	Kinematics            *MachineKinematics // Imported axis speeds/accelerations/limits.
+	Accessories           []Accessory       // Bed-mounted supports, gauges and clamps.
+	DieStack              []DieRiser        // Holders/risers under the die; see toolstack.go.
 	currentPunch          *Punch
 	currentDie            *Die
 	totalPartsBentSession int
+	bedLengthMM           float64 // Usable bed length (0 = unknown, off-center checks skipped).
 }
 
 func NewPressBrake(name string, punch *Punch, die *Die) *PressBrake {
-	return &PressBrake{Name: name, currentPunch: punch, currentDie: die}
+	return &PressBrake{Name: name, MaxTonnage: 100, ThroatDepth: 400, OpenHeight: 480, Clamping: ClampingEuropean, CycleTimes: defaultCycleTimeModel, currentPunch: punch, currentDie: die}
 }
-func (pb *PressBrake) SetPunch(p *Punch) {
+func (pb *PressBrake) SetPunch(p *Punch) error {
+	if err := pb.checkPunchClamping(p); err != nil {
+		log.Printf("WARNING: PressBrake '%s': %v", pb.Name, err)
+		return err
+	}
 	pb.currentPunch = p
 	log.Printf("INFO: PressBrake '%s' punch set to: '%s'", pb.Name, p.Name)
+	return nil
 }
 func (pb *PressBrake) SetDie(d *Die) {
 	pb.currentDie = d
@@ -333,7 +380,29 @@ func (pb *PressBrake) ProcessJob(j *Job) (*SheetMetal, error) {
 
 	j.Sheet.ResetForm() // Start with a flat sheet for this job processing run
 
+	if fitWarnings, fitErr := CheckThroatFeasibility(j, pb); fitErr == nil {
+		for _, w := range fitWarnings {
+			log.Printf("  WARNING: Machine fit: %s", w)
+		}
+	}
+	if accWarnings, accErr := CheckAccessoryInterference(j, pb); accErr == nil {
+		for _, w := range accWarnings {
+			log.Printf("  WARNING: Accessory: %s", w)
+		}
+	}
+	if dev, devErr := ComputeDevelopedLength(j); devErr == nil && len(dev.Bends) > 0 {
+		log.Printf("  Developed length: flat %.1fmm forms %.1fmm outside (deduction %.1fmm over %d bends).",
+			dev.FlatLengthMM, dev.OutsideSumMM, dev.TotalDeduction, len(dev.Bends))
+	}
+	if marking, markErr := AssessMarkingRisk(j.Sheet, pb.currentDie, j.Sheet.Protection); markErr == nil && marking.Message != "" {
+		log.Printf("  WARNING: Surface: %s", marking.Message)
+	}
+
 	for i, step := range j.Steps {
+		if step.Disabled {
+			log.Printf("  Skipping Step %d/%d (disabled).", i+1, len(j.Steps))
+			continue
+		}
 		// Placeholder for actual bend simulation logic
 		// This would involve:
 		// - Validating if the bend is possible with current tooling, material properties, and sheet geometry.
@@ -342,6 +411,23 @@ func (pb *PressBrake) ProcessJob(j *Job) (*SheetMetal, error) {
 		// - Checking for collisions.
 		log.Printf("  Simulating Step %d/%d: Bend at %.2fmm, Angle %.2f°, Radius %.2fmm, Dir %s",
 			i+1, len(j.Steps), step.Position, step.TargetAngle, step.Radius, step.Direction)
+		if whip, whipErr := WhipUpForStep(j.Sheet, step); whipErr == nil && whip.OperatorRisk {
+			log.Printf("  WARNING: Step %d: %s", i+1, whip.Message)
+		}
+		if oc := AssessOffCenterLoad(j.Sheet, step.StationOffsetMM, pb); oc != nil && oc.Exceeded {
+			log.Printf("  WARNING: Step %d: %s", i+1, oc.Message)
+		}
+		if tonnes, tErr := RequiredTonnesForStep(j.Sheet, step, pb.currentDie, pb); tErr == nil {
+			if pb.MaxTonnage > 0 && tonnes > pb.MaxTonnage {
+				log.Printf("  WARNING: Step %d: requires %.1ft, exceeding machine capacity (%.0ft).", i+1, tonnes, pb.MaxTonnage)
+			} else {
+				log.Printf("  Step %d: requires %.1ft of %.0ft capacity.", i+1, tonnes, pb.MaxTonnage)
+			}
+		}
+		if sbk, sbkErr := PredictSpringback(j.Sheet, step, pb.currentPunch); sbkErr == nil && sbk.SpringbackDeg >= 0.1 {
+			log.Printf("  Step %d: springback %.2f° predicted; over-bend to %.2f° to relax to %.2f°.",
+				i+1, sbk.SpringbackDeg, sbk.OverBendTarget, step.TargetAngle)
+		}
 		j.Sheet.CurrentBends = append(j.Sheet.CurrentBends, *step) // Record the conceptual bend
 	}
 
@@ -351,39 +437,65 @@ func (pb *PressBrake) ProcessJob(j *Job) (*SheetMetal, error) {
 }
 func (pb *PressBrake) GetTotalPartsBentSession() int { return pb.totalPartsBentSession }
 
-// GenerateSVGProfile creates a simplified SVG representation of the sheet's profile.
-// This is a stub; a real implementation would draw the formed sheet accurately.
-func GenerateSVGProfile(sheet *SheetMetal, filePath string) error {
+// GenerateSVGProfile creates an SVG representation of the sheet's profile.
+// With bends recorded it draws the true folded cross-section (legs and
+// arcs from the geometry engine); a flat sheet still draws as a bar.
+// Annotation pins from the job, if any, are drawn on top of the profile, and the
+// reference edge is marked (refFlipped draws it on the far edge).
+func GenerateSVGProfile(sheet *SheetMetal, pins []AnnotationPin, refFlipped bool, filePath string) error {
 	if sheet == nil { return fmt.Errorf("sheet is nil for SVG generation") }
 
-	// Basic SVG with a rectangle representing the sheet and some text.
-	// A more advanced version would iterate through sheet.CurrentBends to draw lines/arcs.
 	svgWidth := sheet.OriginalLength + 40 // Add padding
 	svgHeight := 100.0
 
+	// Folded geometry drives the viewport when bends exist.
+	var cs *CrossSection
+	var csScale, csTx, csTy float64
+	if len(sheet.CurrentBends) > 0 {
+		if built, csErr := BuildCrossSection(sheet); csErr == nil {
+			cs = built
+			min, max := cs.Bounds()
+			spanX := max.X - min.X + 1e-6
+			spanY := max.Y - min.Y + 1e-6
+			csScale = (svgWidth - 40) / spanX
+			svgHeight = spanY*csScale + 80
+			if svgHeight < 100 { svgHeight = 100 }
+			csTx = 20 - min.X*csScale
+			csTy = 50 + max.Y*csScale // Top of the part sits below the info text (Y flipped).
+		}
+	}
+
+	rp := GetExportRenderProfile()
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("<svg width=\"%.1f\" height=\"%.1f\" xmlns=\"http://www.w3.org/2000/svg\" style=\"background-color: #f8f9fa; border: 1px solid #dee2e6; font-family: sans-serif;\">\n", svgWidth, svgHeight))
+	sb.WriteString(fmt.Sprintf("<svg width=\"%.1f\" height=\"%.1f\" xmlns=\"http://www.w3.org/2000/svg\" style=\"background-color: %s; border: 1px solid #dee2e6; font-family: sans-serif;\">\n", svgWidth, svgHeight, rp.Background))
 	sb.WriteString(fmt.Sprintf("  <title>Profile: %s</title>\n", sheet.ID))
 	sb.WriteString("  <defs>\n")
 	sb.WriteString("    <style>\n")
-	sb.WriteString("      .info-text { font-size: 10px; fill: #495057; }\n")
-	sb.WriteString("      .sheet-rect { fill: #e9ecef; stroke: #adb5bd; stroke-width: 0.5; }\n")
+	sb.WriteString(fmt.Sprintf("      .info-text { font-size: 10px; fill: %s; }\n", rp.TextColor))
+	sb.WriteString(fmt.Sprintf("      .sheet-rect { fill: %s; stroke: %s; stroke-width: %.1f; }\n", rp.SheetFill, rp.OutlineColor, rp.OutlineWeight))
 	sb.WriteString("    </style>\n")
 	sb.WriteString("  </defs>\n")
 
-	// Sheet representation
+	// Sheet representation: folded cross-section when bends exist, flat bar otherwise.
 	sheetDisplayHeight := sheet.Thickness * 8 // Visual scaling for thickness
 	if sheetDisplayHeight < 5 { sheetDisplayHeight = 5 }
 	if sheetDisplayHeight > 40 { sheetDisplayHeight = 40 }
-	sb.WriteString(fmt.Sprintf("  <rect x=\"20\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" class=\"sheet-rect\" />\n", (svgHeight-sheetDisplayHeight)/2, sheet.OriginalLength, sheetDisplayHeight))
+	if cs != nil {
+		sb.WriteString(fmt.Sprintf("  <g transform=\"translate(%.2f,%.2f) scale(%.4f,%.4f)\">\n", csTx, csTy, csScale, -csScale))
+		sb.WriteString(fmt.Sprintf("    <path d=\"%s\" fill=\"none\" stroke=\"%s\" stroke-width=\"%.3f\" stroke-linejoin=\"round\" stroke-linecap=\"round\" />\n",
+			cs.SVGPathData(), rp.OutlineColor, sheet.Thickness))
+		sb.WriteString("  </g>\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("  <rect x=\"20\" y=\"%.1f\" width=\"%.1f\" height=\"%.1f\" class=\"sheet-rect\" />\n", (svgHeight-sheetDisplayHeight)/2, sheet.OriginalLength, sheetDisplayHeight))
+	}
 
 	// Info text
-	sb.WriteString(fmt.Sprintf("  <text x=\"10\" y=\"15\" class=\"info-text\">Sheet ID: %s (Stub SVG)</text>\n", sheet.ID))
+	sb.WriteString(fmt.Sprintf("  <text x=\"10\" y=\"15\" class=\"info-text\">Sheet ID: %s</text>\n", sheet.ID))
 	sb.WriteString(fmt.Sprintf("  <text x=\"10\" y=\"30\" class=\"info-text\">L:%.1f, W:%.1f, T:%.1f, Material: %s</text>\n", sheet.OriginalLength, sheet.Width, sheet.Thickness, sheet.Material.Name))
 	sb.WriteString(fmt.Sprintf("  <text x=\"10\" y=\"%.1f\" class=\"info-text\">Bends Defined: %d</text>\n", svgHeight-10, len(sheet.CurrentBends)))
 
-	// Placeholder for actual bend lines/arcs based on sheet.CurrentBends
-	// ...
+	sb.WriteString(svgReferenceEdgeMarker(refFlipped, sheet.OriginalLength, (svgHeight-sheetDisplayHeight)/2, (svgHeight+sheetDisplayHeight)/2))
+	sb.WriteString(svgAnnotationPins(pins, AnnotationOnProfile))
 
 	sb.WriteString("</svg>\n")
 
@@ -435,31 +547,47 @@ type AppController struct {
 	bendList          widget.List
 	toolingStatusText string
 	partsBentText     string
-	statusText        string
-	statusColor       color.NRGBA
+	statusBar         *StatusBar
 
 	// Profile Image Display
 	profileImage     image.Image
 	profileImagePath string // Path to the SVG or rasterized image
 	profileImageErr  error
 	profileImageOp   paint.ImageOp
+	profileHoverTag  struct{} // Pointer input tag for the profile view.
+	view3D           *View3D
+	show3DView       bool // Profile panel flipped to the 3D part view.
+	profileSVGDoc    *SVGDocument // Parsed generated SVG for native display.
+	cursorReadout    string   // Live part-coordinate readout under the cursor.
 
 	// Internal & Utility
 	tempDir         string
 	accordionStates map[string]*AccordionItemState
 	clickables      map[string]*widget.Clickable // Central map for buttons
 	uiUpdate        chan struct{}              // For signaling UI redraw from goroutines
-	statusTimer     *time.Timer
-	statusClearLock sync.Mutex
 
 	// Dialog State
 	showDialog          bool
 	dialogTitle         string
 	dialogMessage       string
+	dialogConfirmLabel  string // Confirm button text ("" = "OK").
+	dialogAltLabel      string // Optional third button text ("" = hidden).
 	dialogConfirmAction func()
+	dialogAltAction     func()
 	dialogCancelAction  func()
 	dialogConfirmBtn    widget.Clickable
+	dialogAltBtn        widget.Clickable
 	dialogCancelBtn     widget.Clickable
+
+	// Unsaved-changes tracking.
+	jobDirty bool
+
+	// Sequence-list selection for keyboard nudging (-1 = none).
+	selectedStepIdx int
+
+	// Ctrl-clicked step indices for bulk operations.
+	multiSelected   map[int]bool
+	bulkValueEditor widget.Editor
 }
 
 // AccordionItemState holds state for a collapsible UI panel.
@@ -565,7 +693,10 @@ func NewAppController(win *app.Window) (*AppController, error) {
 		bendList:       widget.List{}, // Initialize list
 		uiUpdate:       make(chan struct{}, 1),
 		clickables:     make(map[string]*widget.Clickable),
+		view3D:         NewView3D(),
 	}
+	ac.statusBar = NewStatusBar(ac.signalUIUpdate)
+	if pb != nil { ac.statusBar.SetMachine(pb.Name) }
 
 	// Initialize UI field values
 	ac.sheetLengthEditor.SetText(fmt.Sprintf("%.1f", currentJobInstance.Sheet.OriginalLength))
@@ -586,12 +717,14 @@ func NewAppController(win *app.Window) (*AppController, error) {
 	if len(ac.dieNames) == 0 { ac.selectedDieIdx = -1 }
 	
 	ac.selectedDirectionIdx = 0 // Default to "Up"
+	ac.selectedStepIdx = -1
 
 	ac.accordionStates = map[string]*AccordionItemState{
 		"Sheet Properties":          {Title: "Sheet Properties", Expanded: true, Content: ac.layoutSheetPanel},
 		"Tooling Setup":             {Title: "Tooling Setup", Expanded: true, Content: ac.layoutToolingPanel},
 		"Define Bend Step":          {Title: "Define Bend Step", Expanded: true, Content: ac.layoutBendDefinitionPanel},
 		"Current Job Bend Sequence": {Title: "Current Job Bend Sequence", Expanded: true, Content: ac.layoutBendSequencePanel},
+		"Simulation History":        {Title: "Simulation History", Expanded: false, Content: ac.layoutSimHistoryPanel},
 	}
 
 	ac.updateToolingStatusDisplay()
@@ -631,12 +764,14 @@ func (ac *AppController) loop() error {
 			ac.Layout(gtx)
 			e.Frame(gtx.Ops)
 		case key.Event:
-			if e.Name == key.NameEscape && e.State == key.Press {
+			if ac.handleNudgeKey(e) {
+				ac.win.Invalidate()
+			} else if e.Name == key.NameEscape && e.State == key.Press {
 				if ac.showDialog {
 					ac.dismissDialog()
 				} else {
 					log.Println("INFO: Escape pressed, requesting window close.")
-					ac.win.Perform(system.ActionClose)
+					ac.confirmIfJobDirty("exiting", func() { ac.win.Perform(system.ActionClose) })
 				}
 			}
 		default:
@@ -660,17 +795,22 @@ func (ac *AppController) cleanup() {
 
 func (ac *AppController) Layout(gtx layout.Context) layout.Dimensions {
 	splitterWidth := unit.Dp(1)
-	mainUIDimensions := layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-		layout.Flexed(0.4, ac.layoutLeftAccordion),
-		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			size := image.Point{X: gtx.Dp(splitterWidth), Y: gtx.Constraints.Max.Y}
-			rectState := clip.Rect{Max: size}.Push(gtx.Ops)
-			paint.ColorOp{Color: ac.th.Palette.ContrastBg}.Add(gtx.Ops)
-			paint.PaintOp{}.Add(gtx.Ops)
-			rectState.Pop()
-			return layout.Dimensions{Size: size}
+	mainUIDimensions := layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(ac.layoutSummaryHeader),
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Flexed(0.4, ac.layoutLeftAccordion),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					size := image.Point{X: gtx.Dp(splitterWidth), Y: gtx.Constraints.Max.Y}
+					rectState := clip.Rect{Max: size}.Push(gtx.Ops)
+					paint.ColorOp{Color: ac.th.Palette.ContrastBg}.Add(gtx.Ops)
+					paint.PaintOp{}.Add(gtx.Ops)
+					rectState.Pop()
+					return layout.Dimensions{Size: size}
+				}),
+				layout.Flexed(0.6, ac.layoutRightSide),
+			)
 		}),
-		layout.Flexed(0.6, ac.layoutRightSide),
 	)
 
 	if ac.showDialog {
@@ -696,6 +836,8 @@ func (ac *AppController) layoutLeftAccordion(gtx layout.Context) layout.Dimensio
 			layout.Rigid(ac.makeAccordionItem("Define Bend Step")),
 			layout.Rigid(layout.Spacer{Height: unit.Dp(2)}.Layout),
 			layout.Rigid(ac.makeAccordionItem("Current Job Bend Sequence")),
+			layout.Rigid(layout.Spacer{Height: unit.Dp(2)}.Layout),
+			layout.Rigid(ac.makeAccordionItem("Simulation History")),
 		}
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, items...)
 	})
@@ -758,26 +900,12 @@ func (ac *AppController) layoutRightSide(gtx layout.Context) layout.Dimensions {
 	})
 }
 
+// updateStatus posts a transient message to the status bar. Safe to call
+// from any goroutine; persistent sections are unaffected.
 func (ac *AppController) updateStatus(msg string, isError bool) {
 	log.Println("UI STATUS:", msg)
-	ac.statusText = msg
-	if isError { ac.statusColor = color.NRGBA{R: 0xD0, G: 0x20, B: 0x20, A: 0xFF}
-	} else { ac.statusColor = color.NRGBA{R: 0x20, G: 0x80, B: 0x20, A: 0xFF} }
-
-	ac.statusClearLock.Lock()
-	if ac.statusTimer != nil { ac.statusTimer.Stop() }
-	if !isError {
-		ac.statusTimer = time.AfterFunc(7*time.Second, func() {
-			ac.statusClearLock.Lock()
-			defer ac.statusClearLock.Unlock()
-			if strings.HasSuffix(ac.statusText, msg) || ac.statusText == "Status: Ready" {
-				ac.statusText = "Status: Ready"; ac.statusColor = ac.th.Palette.Fg
-				ac.signalUIUpdate()
-			}
-		})
-	}
-	ac.statusClearLock.Unlock()
-	ac.signalUIUpdate()
+	if isError { PlayCue(CueWarning) }
+	ac.statusBar.Post(msg, isError)
 }
 
 func (ac *AppController) signalUIUpdate() {
@@ -787,6 +915,7 @@ func (ac *AppController) signalUIUpdate() {
 func (ac *AppController) clearProfileImage() {
 	ac.profileImage = nil; ac.profileImagePath = ""; ac.profileImageErr = nil
 	ac.profileImageOp = paint.ImageOp{}
+	ac.profileSVGDoc = nil
 	log.Println("INFO: Profile image display cleared.")
 	ac.signalUIUpdate()
 }
@@ -815,10 +944,17 @@ func (ac *AppController) displayProfileSVG(svgFilePath string) {
 	if _, err := os.Stat(svgFilePath); os.IsNotExist(err) {
 		ac.updateStatus(fmt.Sprintf("SVG file not found: '%s'", svgFilePath), true); ac.clearProfileImage(); return
 	}
-	log.Printf("INFO: SVG profile generated at: %s. (Display as raster/placeholder in Gio)", svgFilePath)
+	ac.clearProfileImage() // Clears old raster image, signals update
 	ac.profileImagePath = svgFilePath
-	ac.clearProfileImage() // Clears old image, signals update
-	ac.updateStatus(fmt.Sprintf("SVG profile: %s (render not implemented)", filepath.Base(svgFilePath)), false)
+	doc, err := ParseSVGFile(svgFilePath)
+	if err != nil {
+		// The cross-section view still draws from geometry.
+		log.Printf("WARNING: Native SVG render of '%s' failed: %v", svgFilePath, err)
+		ac.updateStatus(fmt.Sprintf("SVG profile: %s (geometry view)", filepath.Base(svgFilePath)), false)
+		return
+	}
+	ac.profileSVGDoc = doc
+	ac.updateStatus(fmt.Sprintf("SVG profile rendered: %s", filepath.Base(svgFilePath)), false)
 }
 
 func (ac *AppController) formRow(label string, widgetFn layout.Widget) layout.Widget {
@@ -841,6 +977,11 @@ func (ac *AppController) layoutSheetPanel(gtx layout.Context) layout.Dimensions
 			text := "Select Material"; if len(ac.materialNames) > 0 && ac.selectedMaterialIdx >= 0 && ac.selectedMaterialIdx < len(ac.materialNames) { text = ac.materialNames[ac.selectedMaterialIdx] } else if len(ac.materialNames) == 0 { text = "No Materials" }
 			return material.Button(ac.th, &ac.materialSelectClick, text).Layout(gtx)
 		})),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			text := ac.flatPatternLabel()
+			if text == "" { return layout.Dimensions{} }
+			return material.Label(ac.th, ac.th.TextSize*0.9, text).Layout(gtx)
+		}),
 		layout.Rigid(layout.Spacer{Height: unit.Dp(10)}.Layout),
 		layout.Rigid(material.Button(ac.th, ac.getOrCreateClickable("updateSheetBtn"), "Update Sheet Properties").Layout),
 	)
@@ -860,6 +1001,10 @@ func (ac *AppController) layoutToolingPanel(gtx layout.Context) layout.Dimension
 		}),
 		layout.Rigid(layout.Spacer{Height: unit.Dp(5)}.Layout),
 		layout.Rigid(material.Label(ac.th, ac.th.TextSize, ac.toolingStatusText).Layout),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(5)}.Layout),
+		layout.Rigid(material.Button(ac.th, ac.getOrCreateClickable("allowanceChartBtn"), "Bend Allowance Chart").Layout),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(5)}.Layout),
+		layout.Rigid(material.Button(ac.th, ac.getOrCreateClickable("dieCompareBtn"), "Compare Dies for Step").Layout),
 	)
 }
 
@@ -886,16 +1031,63 @@ func (ac *AppController) layoutBendSequencePanel(gtx layout.Context) layout.Dime
 				if i < 0 || i >= len(ac.currentJob.Steps) { return layout.Dimensions{} }
 				step := ac.currentJob.Steps[i]
 				if step == nil { return material.Label(ac.th, ac.th.TextSize*0.9, "Error: Nil step data").Layout(gtx) }
-				text := fmt.Sprintf("Step %d: Pos:%.1f, Ang:%.1f°, Rad:%.1f, Dir:%s", step.SequenceOrder, step.Position, step.TargetAngle, step.Radius, step.Direction)
-				return layout.Inset{Top: unit.Dp(2), Bottom: unit.Dp(2), Left: unit.Dp(4), Right: unit.Dp(4)}.Layout(gtx, material.Label(ac.th, ac.th.TextSize*0.9, text).Layout)
+				text := fmt.Sprintf("Step %d: Pos:%.1f, Ang:%s, Rad:%.1f, Dir:%s", step.SequenceOrder, step.Position, FormatAngle(step.TargetAngle, ac.currentJob.AngleConv), step.Radius, step.Direction)
+				label := material.Label(ac.th, ac.th.TextSize*0.9, text)
+				if i == ac.selectedStepIdx { // Nudge target; arrow keys act on this step.
+					label.Text = "▶ " + label.Text
+					label.Color = color.NRGBA{R: 0x1A, G: 0x73, B: 0xE8, A: 0xFF}
+				}
+				if ac.multiSelected[i] { // In the bulk-operation set (Ctrl-click).
+					label.Text = "☑ " + label.Text
+					label.Color = color.NRGBA{R: 0x6A, G: 0x1B, B: 0x9A, A: 0xFF}
+				}
+				if step.Disabled { // Greyed out; clicking the row re-enables it.
+					label.Text += " (skipped)"
+					label.Color = color.NRGBA{R: 0x9E, G: 0x9E, B: 0x9E, A: 0xFF}
+				}
+				row := func(gtx layout.Context) layout.Dimensions {
+					return layout.Inset{Top: unit.Dp(2), Bottom: unit.Dp(2), Left: unit.Dp(4), Right: unit.Dp(4)}.Layout(gtx, label.Layout)
+				}
+				return ac.getOrCreateClickable(fmt.Sprintf("stepToggleBtn%d", i)).Layout(gtx, row)
 			})
 		}),
+		layout.Rigid(ac.layoutBulkOpsBar),
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 			return layout.Inset{Top: unit.Dp(8)}.Layout(gtx, material.Button(ac.th, ac.getOrCreateClickable("clearBendsBtn"), "Clear All Bend Steps").Layout)
 		}),
 	)
 }
 
+// layoutBulkOpsBar shows the bulk-operation controls while steps are
+// Ctrl-selected in the sequence list.
+func (ac *AppController) layoutBulkOpsBar(gtx layout.Context) layout.Dimensions {
+	if len(ac.multiSelected) == 0 { return layout.Dimensions{} }
+	return layout.Inset{Top: unit.Dp(6)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(material.Label(ac.th, ac.th.TextSize*0.9, fmt.Sprintf("%d step(s) selected", len(ac.multiSelected))).Layout),
+			layout.Rigid(ac.formRow("Value (mm):", material.Editor(ac.th, &ac.bulkValueEditor, "e.g., 5 or -2.5").Layout)),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+					layout.Flexed(1, material.Button(ac.th, ac.getOrCreateClickable("bulkShiftBtn"), "Shift").Layout),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout),
+					layout.Flexed(1, material.Button(ac.th, ac.getOrCreateClickable("bulkRadiusBtn"), "Radius").Layout),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout),
+					layout.Flexed(1, material.Button(ac.th, ac.getOrCreateClickable("bulkFlipBtn"), "Flip Dir").Layout),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout),
+					layout.Flexed(1, material.Button(ac.th, ac.getOrCreateClickable("bulkDeleteBtn"), "Delete").Layout),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout),
+					layout.Flexed(1, material.Button(ac.th, ac.getOrCreateClickable("bulkClearSelBtn"), "Deselect").Layout),
+				)
+			}),
+		)
+	})
+}
+
+func (ac *AppController) layoutSimHistoryPanel(gtx layout.Context) layout.Dimensions {
+	label := material.Label(ac.th, ac.th.TextSize*0.85, FormatSimHistory(ac.currentJob))
+	return layout.UniformInset(unit.Dp(4)).Layout(gtx, label.Layout)
+}
+
 func (ac *AppController) layoutExecutionPanel(gtx layout.Context) layout.Dimensions {
 	return layout.Flex{Axis: layout.Vertical, Spacing: layout.SpaceAround, Alignment: layout.Middle}.Layout(gtx,
 		layout.Rigid(material.Button(ac.th, ac.getOrCreateClickable("executeBtn"), "Run Bend Process").Layout),
@@ -905,24 +1097,112 @@ func (ac *AppController) layoutExecutionPanel(gtx layout.Context) layout.Dimensi
 }
 
 func (ac *AppController) layoutProfileDisplayPanel(gtx layout.Context) layout.Dimensions {
-	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-		if ac.profileImage != nil && ac.profileImageErr == nil {
-			imgWidget := widget.Image{Src: ac.profileImageOp, Fit: widget.Contain}
-			maxDim := gtx.Dp(400); imgConstraints := gtx.Constraints
-			if imgConstraints.Max.X > maxDim { imgConstraints.Max.X = maxDim }
-			if imgConstraints.Max.Y > maxDim { imgConstraints.Max.Y = maxDim }
-			imgGtx := gtx; imgGtx.Constraints = imgConstraints
-			return imgWidget.Layout(imgGtx)
-		} else if ac.profileImageErr != nil { return material.Label(ac.th, ac.th.TextSize, "Error displaying profile: "+ac.profileImageErr.Error()).Layout(gtx)
-		} else if ac.profileImagePath != "" { return material.Label(ac.th, ac.th.TextSize, "Profile: "+filepath.Base(ac.profileImagePath)+"\n(SVG rendering stubbed)").Layout(gtx) }
-		return material.Label(ac.th, ac.th.TextSize, "Profile Display Area").Layout(gtx)
-	})
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			if ac.show3DView {
+				return ac.layout3DView(gtx)
+			}
+			return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				if ac.profileImage != nil && ac.profileImageErr == nil {
+					imgWidget := widget.Image{Src: ac.profileImageOp, Fit: widget.Contain}
+					maxDim := gtx.Dp(400); imgConstraints := gtx.Constraints
+					if imgConstraints.Max.X > maxDim { imgConstraints.Max.X = maxDim }
+					if imgConstraints.Max.Y > maxDim { imgConstraints.Max.Y = maxDim }
+					imgGtx := gtx; imgGtx.Constraints = imgConstraints
+					dims := imgWidget.Layout(imgGtx)
+					ac.handleProfileHover(gtx, dims)
+					return dims
+				} else if ac.profileImageErr != nil { return material.Label(ac.th, ac.th.TextSize, "Error displaying profile: "+ac.profileImageErr.Error()).Layout(gtx)
+				} else if ac.profileSVGDoc != nil { return ac.profileSVGDoc.Layout(gtx, ac.th)
+				} else if ac.profileImagePath != "" { return ac.layoutCrossSectionView(gtx) }
+				return material.Label(ac.th, ac.th.TextSize, "Profile Display Area").Layout(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if ac.cursorReadout == "" { return layout.Dimensions{} }
+			return layout.UniformInset(unit.Dp(2)).Layout(gtx, material.Label(ac.th, ac.th.TextSize*0.85, ac.cursorReadout).Layout)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if RequireFeature(Feature3D) != nil { return layout.Dimensions{} }
+			label := "3D View"
+			if ac.show3DView { label = "2D Profile" }
+			return layout.UniformInset(unit.Dp(2)).Layout(gtx, material.Button(ac.th, ac.getOrCreateClickable("toggle3DBtn"), label).Layout)
+		}),
+	)
+}
+
+// handleProfileHover registers pointer input over the drawn profile and
+// updates the coordinate readout (with feature snapping) as the cursor
+// moves. Must be called with the dimensions of the laid-out image.
+func (ac *AppController) handleProfileHover(gtx layout.Context, dims layout.Dimensions) {
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil { return }
+
+	vm := ViewMapper{
+		SheetLength: ac.currentJob.Sheet.OriginalLength,
+		WidthPx:     float64(dims.Size.X),
+		Flipped:     ac.currentJob.ReferenceFlipped,
+	}
+	for _, ev := range gtx.Queue.Events(&ac.profileHoverTag) {
+		pe, ok := ev.(pointer.Event)
+		if !ok { continue }
+		switch pe.Kind {
+		case pointer.Move:
+			if x, inside := vm.PartX(float64(pe.Position.X)); inside {
+				snap := SnapPartX(x, ac.currentJob.Sheet, ac.currentJob.Steps, snapGridMM/2)
+				ac.cursorReadout = FormatCursorReadout(snap, ac.currentJob.Steps)
+			} else {
+				ac.cursorReadout = ""
+			}
+		case pointer.Press:
+			if x, inside := vm.PartX(float64(pe.Position.X)); inside {
+				snap := SnapPartX(x, ac.currentJob.Sheet, ac.currentJob.Steps, snapGridMM/2)
+				ac.prefillBendAt(snap)
+			}
+		case pointer.Leave, pointer.Cancel:
+			ac.cursorReadout = ""
+		}
+	}
+	defer clip.Rect(image.Rectangle{Max: dims.Size}).Push(gtx.Ops).Pop()
+	pointer.InputOp{Tag: &ac.profileHoverTag, Kinds: pointer.Move | pointer.Press | pointer.Leave | pointer.Cancel}.Add(gtx.Ops)
+}
+
+// prefillBendAt fills the bend definition panel with a position picked by
+// clicking the view. Clicking an existing bend line duplicates its values
+// as the starting point; a free click just sets the snapped position.
+func (ac *AppController) prefillBendAt(snap SnapResult) {
+	if ac.blockInViewerMode() { return }
+	ac.bendPositionEditor.SetText(fmt.Sprintf("%.1f", snap.X))
+	if snap.Target == SnapBendLine && snap.StepIndex >= 0 && snap.StepIndex < len(ac.currentJob.Steps) {
+		if step := ac.currentJob.Steps[snap.StepIndex]; step != nil {
+			ac.bendAngleEditor.SetText(fmt.Sprintf("%.1f", step.TargetAngle))
+			ac.bendRadiusEditor.SetText(fmt.Sprintf("%.1f", step.Radius))
+		}
+	}
+	if state, ok := ac.accordionStates["Define Bend Step"]; ok {
+		state.Expanded = true
+	}
+	ac.updateStatus(fmt.Sprintf("Bend position %.1fmm picked from view.", snap.X), false)
+	ac.signalUIUpdate()
 }
 
 func (ac *AppController) layoutStatusLabel(gtx layout.Context) layout.Dimensions {
-	label := material.Label(ac.th, ac.th.TextSize*0.9, ac.statusText)
-	label.Color = ac.statusColor; label.MaxLines = 2
-	return layout.UniformInset(unit.Dp(4)).Layout(gtx, label.Layout)
+	sections, msg, isError := ac.statusBar.Snapshot()
+	msgColor := color.NRGBA{R: 0x20, G: 0x80, B: 0x20, A: 0xFF}
+	if isError { msgColor = color.NRGBA{R: 0xD0, G: 0x20, B: 0x20, A: 0xFF} }
+	return layout.UniformInset(unit.Dp(4)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Spacing: layout.SpaceBetween, Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				msgLabel := material.Label(ac.th, ac.th.TextSize*0.9, msg)
+				msgLabel.Color = msgColor; msgLabel.MaxLines = 2
+				return msgLabel.Layout(gtx)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				secLabel := material.Label(ac.th, ac.th.TextSize*0.85, sections)
+				secLabel.Color = ac.th.Palette.Fg; secLabel.MaxLines = 1
+				return secLabel.Layout(gtx)
+			}),
+		)
+	})
 }
 
 func (ac *AppController) processEvents(gtx layout.Context) {
@@ -940,17 +1220,21 @@ func (ac *AppController) processEvents(gtx layout.Context) {
 		}
 	}
 	if ac.punchSelectClick.Clicked(gtx) {
-		if len(ac.punchNames) > 0 {
+		if !ac.blockIfToolingLocked() && len(ac.punchNames) > 0 {
 			ac.selectedPunchIdx = (ac.selectedPunchIdx + 1) % len(ac.punchNames)
 			if ac.toolingManager != nil && ac.pressBrake != nil && ac.selectedPunchIdx < len(ac.punchNames) {
 				if punch, ok := ac.toolingManager.GetPunchByName(ac.punchNames[ac.selectedPunchIdx]); ok {
-					ac.pressBrake.SetPunch(punch); ac.updateToolingStatusDisplay(); ac.updateStatus(fmt.Sprintf("Punch set to: %s", punch.Name), false)
+					if err := ac.pressBrake.SetPunch(punch); err != nil {
+						ac.updateStatus(err.Error(), true)
+					} else {
+						ac.updateToolingStatusDisplay(); ac.updateStatus(fmt.Sprintf("Punch set to: %s", punch.Name), false)
+					}
 				}
 			}
 		}
 	}
 	if ac.dieSelectClick.Clicked(gtx) {
-		if len(ac.dieNames) > 0 {
+		if !ac.blockIfToolingLocked() && len(ac.dieNames) > 0 {
 			ac.selectedDieIdx = (ac.selectedDieIdx + 1) % len(ac.dieNames)
 			if ac.toolingManager != nil && ac.pressBrake != nil && ac.selectedDieIdx < len(ac.dieNames) {
 				if die, ok := ac.toolingManager.GetDieByName(ac.dieNames[ac.selectedDieIdx]); ok {
@@ -966,20 +1250,53 @@ func (ac *AppController) processEvents(gtx layout.Context) {
 	if ac.getOrCreateClickable("addBendBtn").Clicked(gtx) { ac.handleAddBendStep() }
 	if ac.getOrCreateClickable("clearBendsBtn").Clicked(gtx) { ac.handleClearBendSequence() }
 	if ac.getOrCreateClickable("executeBtn").Clicked(gtx) { ac.handleExecuteBendProcess() }
+	if ac.getOrCreateClickable("allowanceChartBtn").Clicked(gtx) { ac.showAllowanceChart() }
+	if ac.getOrCreateClickable("dieCompareBtn").Clicked(gtx) { ac.showDieComparison() }
+	if ac.getOrCreateClickable("toggle3DBtn").Clicked(gtx) {
+		ac.show3DView = !ac.show3DView
+		if ac.show3DView { ac.view3D.ResetCamera() }
+	}
+	if ac.currentJob != nil {
+		for i, step := range ac.currentJob.Steps {
+			if step == nil { continue }
+			for _, click := range ac.getOrCreateClickable(fmt.Sprintf("stepToggleBtn%d", i)).Update(gtx) {
+				if click.Modifiers.Contain(key.ModCtrl) { ac.toggleMultiSelect(i); continue } // Ctrl-click builds the bulk set.
+				if ac.selectedStepIdx != i { ac.selectStep(i); continue } // First click selects; second toggles skip.
+				if ac.blockInViewerMode() { continue }
+				step.Disabled = !step.Disabled
+				ac.markJobDirty()
+				state := "re-enabled"
+				if step.Disabled { state = "skipped" }
+				ac.updateStatus(fmt.Sprintf("Step %d %s.", step.SequenceOrder, state), false)
+				ac.signalUIUpdate()
+			}
+		}
+		if ac.getOrCreateClickable("bulkShiftBtn").Clicked(gtx) {
+			if dx, err := ParseUserFloat(ac.bulkValueEditor.Text()); err != nil { ac.updateStatus("Invalid shift value.", true) } else { ac.shiftSelectedSteps(dx) }
+		}
+		if ac.getOrCreateClickable("bulkRadiusBtn").Clicked(gtx) {
+			if r, err := ParseUserFloat(ac.bulkValueEditor.Text()); err != nil { ac.updateStatus("Invalid radius value.", true) } else { ac.setSelectedRadius(r) }
+		}
+		if ac.getOrCreateClickable("bulkFlipBtn").Clicked(gtx) { ac.flipSelectedDirections() }
+		if ac.getOrCreateClickable("bulkDeleteBtn").Clicked(gtx) { ac.deleteSelectedSteps() }
+		if ac.getOrCreateClickable("bulkClearSelBtn").Clicked(gtx) { ac.clearMultiSelect() }
+	}
 	if ac.showDialog {
-		if ac.dialogConfirmBtn.Clicked(gtx) { ac.dismissDialog(); if ac.dialogConfirmAction != nil { ac.dialogConfirmAction() } }
-		if ac.dialogCancelBtn.Clicked(gtx) { ac.dismissDialog(); if ac.dialogCancelAction != nil { ac.dialogCancelAction() } }
+		if ac.dialogConfirmBtn.Clicked(gtx) { action := ac.dialogConfirmAction; ac.dismissDialog(); if action != nil { action() } }
+		if ac.dialogAltBtn.Clicked(gtx) { action := ac.dialogAltAction; ac.dismissDialog(); if action != nil { action() } }
+		if ac.dialogCancelBtn.Clicked(gtx) { action := ac.dialogCancelAction; ac.dismissDialog(); if action != nil { action() } }
 	}
 }
 
 func (ac *AppController) handleSheetUpdate() {
+	if ac.blockInViewerMode() { return }
 	if ac.currentJob == nil || ac.currentJob.Sheet == nil { ac.updateStatus("No active job/sheet to update.", true); return }
-	length, errL := strconv.ParseFloat(ac.sheetLengthEditor.Text(), 64)
-	thickness, errT := strconv.ParseFloat(ac.sheetThicknessEditor.Text(), 64)
-	width, errW := strconv.ParseFloat(ac.sheetWidthEditor.Text(), 64)
+	length, errL := ParseUserFloat(ac.sheetLengthEditor.Text())
+	thickness, errT := ParseUserFloat(ac.sheetThicknessEditor.Text())
+	width, errW := ParseUserFloat(ac.sheetWidthEditor.Text())
 	if errL != nil || errT != nil || errW != nil { ac.updateStatus("Invalid sheet dimensions. Please use numbers.", true); return }
-	if length < minSheetDimension || length > maxSheetDimension || thickness < minSheetDimension || thickness > maxSheetDimension || width < minSheetDimension || width > maxSheetDimension {
-		ac.updateStatus(fmt.Sprintf("Sheet dimensions out of range (%.1f-%.1fmm).", minSheetDimension, maxSheetDimension), true); return
+	for _, dim := range []float64{length, thickness, width} {
+		if err := CheckWithExceptions(ac.currentJob, RuleSheetDimension, dim); err != nil { ac.updateStatus(err.Error(), true); return }
 	}
 	var selectedMaterialDetails MaterialDetails; ok := false
 	if ac.selectedMaterialIdx >= 0 && ac.selectedMaterialIdx < len(ac.materialNames) {
@@ -989,39 +1306,70 @@ func (ac *AppController) handleSheetUpdate() {
 	} else { ac.updateStatus("No material selected or selection is invalid.", true); return }
 	ac.currentJob.Sheet.OriginalLength = length; ac.currentJob.Sheet.Thickness = thickness; ac.currentJob.Sheet.Width = width
 	ac.currentJob.Sheet.Material = selectedMaterialDetails; ac.currentJob.Sheet.ResetForm()
+	ac.markJobDirty()
 	ac.clearProfileImage(); ac.updateStatus(fmt.Sprintf("Sheet properties updated for job '%s'.", ac.currentJob.Name), false)
 }
 
 func (ac *AppController) handleAddBendStep() {
+	if ac.blockInViewerMode() { return }
 	if ac.jobController == nil { ac.updateStatus("Job controller not initialized.", true); return }
 	if ac.currentJob == nil || ac.currentJob.Sheet == nil { ac.updateStatus("Cannot add bend: No active job or sheet defined.", true); return }
 	posStr := ac.bendPositionEditor.Text(); angleStr := ac.bendAngleEditor.Text(); radStr := ac.bendRadiusEditor.Text()
-	pos, errP := strconv.ParseFloat(posStr, 64); angle, errA := strconv.ParseFloat(angleStr, 64); radius, errR := strconv.ParseFloat(radStr, 64)
+	pos, errP := ParseUserFloat(posStr); angle, errA := ParseUserFloat(angleStr); radius, errR := ParseUserFloat(radStr)
 	if errP != nil || errA != nil || errR != nil { ac.updateStatus("Invalid bend parameters. Ensure numbers.", true); return }
+	// Entered angles follow the job's convention; storage stays internal.
+	if angle, errA = ToInternalAngle(angle, ac.currentJob.AngleConv); errA != nil { ac.updateStatus(errA.Error(), true); return }
 	direction := BendDirectionUp; if ac.selectedDirectionIdx >= 0 && ac.selectedDirectionIdx < len(ac.bendDirections) { direction = BendDirection(ac.bendDirections[ac.selectedDirectionIdx]) }
 	if pos <= 0 || pos >= ac.currentJob.Sheet.OriginalLength { ac.updateStatus(fmt.Sprintf("Bend position %.1fmm outside sheet (0-%.1fmm).", pos, ac.currentJob.Sheet.OriginalLength), true); return }
 	if radius < minBendRadius || radius > maxBendRadius { ac.updateStatus(fmt.Sprintf("Bend radius %.2fmm outside range (%.1f-%.1fmm).", radius, minBendRadius, maxBendRadius), true); return }
-	if angle < minBendAngle || angle > maxBendAngle { ac.updateStatus(fmt.Sprintf("Bend angle %.1f° outside range (%.1f-%.1f°).", angle, minBendAngle, maxBendAngle), true); return }
-	minSheetRadius := ac.currentJob.Sheet.GetMinBendRadius()
-	addStepAction := func() {
-		if _, err := ac.jobController.AddBendStepToCurrentJob(pos, angle, radius, direction); err != nil {
-			ac.updateStatus(fmt.Sprintf("Failed to add bend step: %v", err), true)
-		} else { ac.updateStatus("New bend step added to current job.", false) }
-		ac.signalUIUpdate()
+	if angle < minBendAngle || angle > maxFlatteningAngleDeg { ac.updateStatus(fmt.Sprintf("Bend angle %.1f° outside range (%.1f-%.1f°).", angle, minBendAngle, maxFlatteningAngleDeg), true); return }
+	// Until bend orientation is captured per step, warn against the
+	// conservative (along-the-grain) minimum radius.
+	minSheetRadius := ac.currentJob.Sheet.GetMinBendRadiusFor(BendLongitudinal)
+	addStepAction := func(overrideRule, overrideMsg string) func() {
+		return func() {
+			step, err := ac.jobController.AddBendStepToCurrentJob(pos, angle, radius, direction)
+			if err != nil {
+				ac.updateStatus(fmt.Sprintf("Failed to add bend step: %v", err), true)
+			} else {
+				ac.markJobDirty()
+				if overrideMsg != "" { // Warning was accepted; record it with the job.
+					if ovErr := ac.jobController.RecordOverride(overrideRule, step.SequenceOrder, overrideMsg, "Operator confirmed via dialog"); ovErr != nil {
+						log.Printf("WARNING: Could not record override: %v", ovErr)
+					}
+				}
+				// Flag coining / die damage risk for the step just added.
+				if die := ac.pressBrake.GetCurrentDie(); die != nil {
+					if assess, aErr := AssessCoiningRisk(ac.currentJob.Sheet, step, die, ac.pressBrake); aErr == nil && assess.Message != "" {
+						ac.updateStatus(assess.Message, assess.DieDamageRisk || assess.MachineOverload)
+					} else {
+						ac.updateStatus("New bend step added to current job.", false)
+					}
+				} else {
+					ac.updateStatus("New bend step added to current job.", false)
+				}
+			}
+			ac.signalUIUpdate()
+		}
 	}
+	flangeMsg := CheckMinFlange(ac.currentJob.Sheet, pos, ac.pressBrake.GetCurrentDie())
 	if radius > 1e-6 && radius < minSheetRadius {
-		ac.showConfirmDialog("Radius Warning", fmt.Sprintf("Radius (%.2fmm) < recommended min (%.2fmm).\nMay cause cracking.\nAdd anyway?", radius, minSheetRadius), addStepAction, func() { ac.updateStatus("Bend addition cancelled.", false) })
-	} else { addStepAction() }
+		warnMsg := fmt.Sprintf("Radius (%.2fmm) < recommended min (%.2fmm).", radius, minSheetRadius)
+		ac.showConfirmDialog("Radius Warning", warnMsg+"\nMay cause cracking.\nAdd anyway?", addStepAction(RuleMinBendRadiusWarn, warnMsg), func() { ac.updateStatus("Bend addition cancelled.", false) })
+	} else if flangeMsg != "" {
+		ac.showConfirmDialog("Short Flange Warning", flangeMsg+"\nAdd anyway?", addStepAction(RuleMinFlangeWarn, flangeMsg), func() { ac.updateStatus("Bend addition cancelled.", false) })
+	} else { addStepAction("", "")() }
 }
 
 func (ac *AppController) handleClearBendSequence() {
+	if ac.blockInViewerMode() { return }
 	if ac.jobController == nil { ac.updateStatus("Job controller not initialized.", true); return }
 	if ac.currentJob == nil { ac.updateStatus("No active job to clear.", true); return }
 	if len(ac.currentJob.Steps) == 0 { ac.updateStatus("No bend steps to clear.", false); return }
 	ac.showConfirmDialog("Clear Bend Sequence", fmt.Sprintf("Remove all %d bend steps from job '%s'?", len(ac.currentJob.Steps), ac.currentJob.Name),
 		func() {
 			if err := ac.jobController.ClearBendStepsFromCurrentJob(); err != nil { ac.updateStatus(fmt.Sprintf("Failed to clear steps: %v", err), true)
-			} else { ac.clearProfileImage(); ac.updateStatus(fmt.Sprintf("All steps cleared for '%s'.", ac.currentJob.Name), false) }
+			} else { ac.markJobDirty(); ac.clearProfileImage(); ac.selectedStepIdx = -1; ac.clearMultiSelect(); ac.updateStatus(fmt.Sprintf("All steps cleared for '%s'.", ac.currentJob.Name), false) }
 			ac.signalUIUpdate()
 		}, nil)
 }
@@ -1038,20 +1386,24 @@ func (ac *AppController) handleExecuteBendProcess() {
 		// This assumes simple field updates are safe enough for this app's concurrency model.
 		// For more complex state, use channels to pass data to the main goroutine for updates.
 		if err != nil {
-			ac.statusText = fmt.Sprintf("Job Processing Error: %v", err); ac.statusColor = color.NRGBA{R:0xD0,G:0x20,B:0x20,A:0xFF}
+			ac.updateStatus(fmt.Sprintf("Job Processing Error: %v", err), true)
 			ac.profileImage = nil; ac.profileImageOp = paint.ImageOp{}; ac.signalUIUpdate(); return
 		}
 		ac.partsBentText = fmt.Sprintf("Parts Bent (Session): %d", ac.pressBrake.GetTotalPartsBentSession())
+		if _, histErr := ac.jobController.RecordSimulationRun(ac.pressBrake); histErr != nil {
+			log.Printf("WARNING: Could not record simulation run: %v", histErr)
+		}
+		GetEventBus().Publish(Event{Type: EventSimulationFinished, Job: ac.currentJob})
 		if processedSheet == nil {
-			ac.statusText = "Job processing returned nil sheet."; ac.statusColor = color.NRGBA{R:0xD0,G:0x20,B:0x20,A:0xFF}; ac.signalUIUpdate(); return
+			ac.updateStatus("Job processing returned nil sheet.", true); ac.signalUIUpdate(); return
 		}
 		svgFileName := filepath.Join(ac.tempDir, fmt.Sprintf("profile_%s_%d.svg", processedSheet.ID, time.Now().UnixNano()))
-		if svgErr := GenerateSVGProfile(processedSheet, svgFileName); svgErr != nil {
-			ac.statusText = fmt.Sprintf("SVG Generation Error: %v", svgErr); ac.statusColor = color.NRGBA{R:0xD0,G:0x20,B:0x20,A:0xFF}
+		if svgErr := GenerateSVGProfile(processedSheet, ac.currentJob.Annotations, ac.currentJob.ReferenceFlipped, svgFileName); svgErr != nil {
+			ac.updateStatus(fmt.Sprintf("SVG Generation Error: %v", svgErr), true)
 			ac.profileImage = nil; ac.profileImageOp = paint.ImageOp{}
 		} else {
-			ac.profileImagePath = svgFileName
-			ac.statusText = fmt.Sprintf("Job '%s' processed. Profile updated.", ac.currentJob.Name); ac.statusColor = color.NRGBA{R:0x20,G:0x80,B:0x20,A:0xFF}
+			ac.displayProfileSVG(svgFileName)
+			ac.updateStatus(fmt.Sprintf("Job '%s' processed. Profile updated.", ac.currentJob.Name), false)
 		}
 		ac.signalUIUpdate()
 	}()
@@ -1060,19 +1412,78 @@ func (ac *AppController) handleExecuteBendProcess() {
 func (ac *AppController) updateToolingStatusDisplay() {
 	punchName, dieName := "None", "None"
 	if ac.pressBrake != nil { if p := ac.pressBrake.GetCurrentPunch(); p != nil { punchName = p.Name }; if d := ac.pressBrake.GetCurrentDie(); d != nil { dieName = d.Name } }
-	ac.toolingStatusText = fmt.Sprintf("Active Tooling: Punch: %s, Die: %s", punchName, dieName); ac.signalUIUpdate()
+	ac.toolingStatusText = fmt.Sprintf("Active Tooling: Punch: %s, Die: %s", punchName, dieName)
+	ac.statusBar.SetTooling(punchName, dieName)
+	GetEventBus().Publish(Event{Type: EventToolingChanged, Job: ac.currentJob})
+	ac.signalUIUpdate()
 }
 func (ac *AppController) updatePartsBentDisplay() {
 	if ac.pressBrake != nil { ac.partsBentText = fmt.Sprintf("Total Parts Bent (Session): %d", ac.pressBrake.GetTotalPartsBentSession())
 	} else { ac.partsBentText = "Total Parts Bent (Session): N/A" }
 	ac.signalUIUpdate()
 }
+// markJobDirty flags the current job as having unsaved changes.
+func (ac *AppController) markJobDirty() {
+	ac.jobDirty = true
+	ac.statusBar.SetDirty(true)
+	GetEventBus().Publish(Event{Type: EventJobChanged, Job: ac.currentJob})
+}
+
+// saveCurrentJob writes the job as a versioned JSON document next to the
+// executable and clears the dirty flag.
+func (ac *AppController) saveCurrentJob() error {
+	if ac.currentJob == nil { return fmt.Errorf("no job to save") }
+	doc, err := WrapDocument(SchemaJob, ac.currentJob)
+	if err != nil { return fmt.Errorf("wrapping job for save: %w", err) }
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil { return fmt.Errorf("encoding job: %w", err) }
+	fileName := fmt.Sprintf("job_%s.json", strings.ReplaceAll(ac.currentJob.Name, " ", "_"))
+	if err := os.WriteFile(fileName, data, 0644); err != nil { return fmt.Errorf("writing job file: %w", err) }
+	ac.jobDirty = false
+	ac.statusBar.SetDirty(false)
+	ac.updateStatus(fmt.Sprintf("Job saved to '%s'.", fileName), false)
+	return nil
+}
+
+// confirmIfJobDirty runs proceed immediately when there is nothing
+// unsaved; otherwise it asks Save/Discard/Cancel first. Cancel simply
+// leaves everything as it is.
+func (ac *AppController) confirmIfJobDirty(what string, proceed func()) {
+	if !ac.jobDirty {
+		proceed()
+		return
+	}
+	ac.showSaveDiscardDialog("Unsaved Changes",
+		fmt.Sprintf("The current job has unsaved changes. Save before %s?", what),
+		func() {
+			if err := ac.saveCurrentJob(); err != nil { ac.updateStatus(fmt.Sprintf("Save failed: %v", err), true); return }
+			proceed()
+		},
+		func() {
+			ac.jobDirty = false
+			ac.statusBar.SetDirty(false)
+			proceed()
+		})
+}
+
 func (ac *AppController) showConfirmDialog(title, message string, onConfirm, onCancel func()) {
 	ac.dialogTitle = title; ac.dialogMessage = message; ac.dialogConfirmAction = onConfirm; ac.dialogCancelAction = onCancel
+	ac.dialogConfirmLabel = ""; ac.dialogAltLabel = ""; ac.dialogAltAction = nil
+	ac.showDialog = true; ac.signalUIUpdate()
+}
+
+// showSaveDiscardDialog presents the three-way Save/Discard/Cancel choice
+// used before destructive actions on an unsaved job.
+func (ac *AppController) showSaveDiscardDialog(title, message string, onSave, onDiscard func()) {
+	ac.dialogTitle = title; ac.dialogMessage = message
+	ac.dialogConfirmAction = onSave; ac.dialogAltAction = onDiscard; ac.dialogCancelAction = nil
+	ac.dialogConfirmLabel = "Save"; ac.dialogAltLabel = "Discard"
 	ac.showDialog = true; ac.signalUIUpdate()
 }
 func (ac *AppController) dismissDialog() {
-	ac.showDialog = false; ac.dialogConfirmAction = nil; ac.dialogCancelAction = nil; ac.signalUIUpdate()
+	ac.showDialog = false; ac.dialogConfirmAction = nil; ac.dialogAltAction = nil; ac.dialogCancelAction = nil
+	ac.dialogConfirmLabel = ""; ac.dialogAltLabel = ""
+	ac.signalUIUpdate()
 }
 
 func (ac *AppController) layoutDialog(gtx layout.Context) layout.Dimensions {
@@ -1094,12 +1505,23 @@ func (ac *AppController) layoutDialog(gtx layout.Context) layout.Dimensions {
 							layout.Rigid(material.Body1(ac.th, ac.dialogMessage).Layout),
 							layout.Rigid(layout.Spacer{Height: unit.Dp(16)}.Layout),
 							layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-								return layout.Flex{Spacing: layout.SpaceAround, Alignment: layout.End}.Layout(gtx,
+								confirmLabel := ac.dialogConfirmLabel
+								if confirmLabel == "" { confirmLabel = "OK" }
+								buttons := []layout.FlexChild{
 									layout.Flexed(1, func(gtx layout.Context) layout.Dimensions { return layout.Dimensions{} }),
 									layout.Rigid(material.Button(ac.th, &ac.dialogCancelBtn, "Cancel").Layout),
+								}
+								if ac.dialogAltLabel != "" {
+									buttons = append(buttons,
+										layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
+										layout.Rigid(material.Button(ac.th, &ac.dialogAltBtn, ac.dialogAltLabel).Layout),
+									)
+								}
+								buttons = append(buttons,
 									layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
-									layout.Rigid(material.Button(ac.th, &ac.dialogConfirmBtn, "OK").Layout),
+									layout.Rigid(material.Button(ac.th, &ac.dialogConfirmBtn, confirmLabel).Layout),
 								)
+								return layout.Flex{Spacing: layout.SpaceAround, Alignment: layout.End}.Layout(gtx, buttons...)
 							}),
 						)
 					})
@@ -1109,17 +1531,22 @@ func (ac *AppController) layoutDialog(gtx layout.Context) layout.Dimensions {
 }
 
 func main() {
+	InstallLogCapture()
+	if err := LoadFeatureConfig(featureConfigFile); err != nil {
+		log.Printf("WARNING: %v", err)
+	}
 	go func() {
 		// If app.NewWindow is undefined, your Gio environment is not resolving the 'gioui.org/app' package.
 		// Please verify your Go module setup (go.mod, `go mod tidy`, GOPATH/GOROOT).
 		win := app.NewWindow(
-			app.Title(appName+" "+appVersion),
+			app.Title(appName+" "+appVersion+viewerTitleSuffix()),
 			app.Size(unit.Dp(1200), unit.Dp(800)),
 		)
 		controller, err := NewAppController(win)
 		if err != nil {
 			log.Fatalf("Failed to initialize AppController: %v", err)
 		}
+		defer controller.recoverToCrashReport()
 		if err := controller.loop(); err != nil {
 			log.Fatalf("Error in application loop: %v", err)
 		}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Conical / tapered bends ---
+// A conical bend has a different target angle at each end of the bend
+// line, produced by running the stroke with deliberate ram tilt (see
+// ramtilt.go). A step is conical when EndAngle is set; straight bends
+// leave it nil and everything behaves as before.
+
+// maxConicalTaperDeg is the largest permitted angle difference between
+// the two ends of a single conical bend. Beyond this the penetration
+// difference exceeds what the tilt limit allows on common bend lengths.
+const maxConicalTaperDeg = 15.0
+
+// IsConical reports whether the step is a tapered bend.
+func (bs *BendStep) IsConical() bool {
+	return bs.EndAngle != nil && *bs.EndAngle != bs.TargetAngle
+}
+
+// TaperDeg returns the angle difference between the far (Y2) end and the
+// near (Y1) end, or 0 for straight bends.
+func (bs *BendStep) TaperDeg() float64 {
+	if bs.EndAngle == nil {
+		return 0
+	}
+	return *bs.EndAngle - bs.TargetAngle
+}
+
+// SetConical marks the step as a tapered bend ending at endAngle on the
+// Y2 side, validating the taper against the machine-independent limit.
+func (bs *BendStep) SetConical(endAngle float64) error {
+	if endAngle < minBendAngle || endAngle > maxBendAngle {
+		return fmt.Errorf("end angle must be between %.1f and %.1f degrees, got %.2f", minBendAngle, maxBendAngle, endAngle)
+	}
+	if taper := math.Abs(endAngle - bs.TargetAngle); taper > maxConicalTaperDeg {
+		return fmt.Errorf("taper of %.1f° exceeds the maximum of %.1f° per bend", taper, maxConicalTaperDeg)
+	}
+	bs.EndAngle = &endAngle
+	return nil
+}
+
+// TiltForStep computes the Y1/Y2 stroke for a (possibly conical) step with
+// the given die and validates it against the ram tilt limit for the bend
+// length (the sheet width).
+func TiltForStep(step *BendStep, sheet *SheetMetal, die *Die) (RamTiltState, error) {
+	if step == nil || sheet == nil {
+		return RamTiltState{}, fmt.Errorf("step and sheet are required for tilt calculation")
+	}
+	endAngle := step.TargetAngle
+	if step.EndAngle != nil {
+		endAngle = *step.EndAngle
+	}
+	rt, err := TiltForAngles(step.TargetAngle, endAngle, die)
+	if err != nil {
+		return RamTiltState{}, err
+	}
+	if err := CheckRamTilt(rt, sheet.Width); err != nil {
+		return RamTiltState{}, fmt.Errorf("conical bend at %.1fmm: %w", step.Position, err)
+	}
+	return rt, nil
+}
+
+// describeStepAngle formats the angle column for a step in the given
+// convention, showing both end angles for conical bends (used by the
+// setup sheet and status texts).
+func describeStepAngle(step *BendStep, conv AngleConvention) string {
+	if step.IsConical() {
+		return fmt.Sprintf("%s→%s", FormatAngle(step.TargetAngle, conv), FormatAngle(*step.EndAngle, conv))
+	}
+	return FormatAngle(step.TargetAngle, conv)
+}
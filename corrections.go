@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Job-wide export corrections ---
+// Real controllers keep a corrections page: after the first article
+// measures long or opens up, the operator dials a global position offset
+// or angle correction instead of editing every bend. The same lives here
+// at job level. The programmed values never change — corrections are
+// applied only when a program leaves for the machine, and every export
+// that carries them says so.
+
+// GlobalCorrections are the job's export-time tuning values.
+type GlobalCorrections struct {
+	PositionOffsetMM float64 // Added to every bend position on export.
+	AngleOffsetDeg   float64 // Added to every target angle on export.
+}
+
+// Active reports whether any correction is dialed in.
+func (gc GlobalCorrections) Active() bool {
+	return gc.PositionOffsetMM != 0 || gc.AngleOffsetDeg != 0
+}
+
+// SetGlobalCorrections records the job's corrections page.
+func (j *Job) SetGlobalCorrections(gc GlobalCorrections) error {
+	if j == nil {
+		return fmt.Errorf("job is nil for corrections")
+	}
+	j.Corrections = gc
+	log.Printf("INFO: Global corrections for job '%s': position %+.2fmm, angle %+.2f°.",
+		j.Name, gc.PositionOffsetMM, gc.AngleOffsetDeg)
+	return nil
+}
+
+// correctedPosition applies the job's position offset for export.
+func correctedPosition(j *Job, pos float64) float64 {
+	return pos + j.Corrections.PositionOffsetMM
+}
+
+// correctedAngle applies the job's angle correction for export, clamped
+// to the machine's usable band.
+func correctedAngle(j *Job, angle float64) float64 {
+	a := angle + j.Corrections.AngleOffsetDeg
+	if a < minBendAngle {
+		a = minBendAngle
+	}
+	if a > maxFlatteningAngleDeg {
+		a = maxFlatteningAngleDeg
+	}
+	return a
+}
+
+// correctionsBlock renders the corrections page for the setup sheet ("" when
+// nothing is dialed in).
+func correctionsBlock(j *Job) string {
+	if j == nil || !j.Corrections.Active() {
+		return ""
+	}
+	return fmt.Sprintf("\nGlobal Corrections (applied on export only):\n  Position offset: %+.2fmm\n  Angle correction: %+.2f°\n",
+		j.Corrections.PositionOffsetMM, j.Corrections.AngleOffsetDeg)
+}
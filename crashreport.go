@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// --- Crash reports ---
+// When the application panics on a shop PC nobody copies the console.
+// A deferred recover captures the stack, the most recent log lines and an
+// anonymized snapshot of the current job into a local report file the user
+// can attach to an issue. Anonymization strips who the part was for —
+// customer, order, notes, certificates, attachment paths, approvals — and keeps the
+// geometry and bend data that actually reproduce the bug.
+
+// crashLogLines is how many recent log lines a report includes.
+const crashLogLines = 200
+
+// logRing keeps the most recent log lines in memory for crash reports.
+type logRing struct {
+	mu    sync.Mutex
+	lines []string
+	next  int
+	full  bool
+}
+
+// recentLog is the application-wide log ring, fed via InstallLogCapture.
+var recentLog = &logRing{lines: make([]string, crashLogLines)}
+
+// Write stores one log line; the ring also forwards nothing — it is used
+// as a tee target alongside the original log output.
+func (lr *logRing) Write(p []byte) (int, error) {
+	lr.mu.Lock()
+	lr.lines[lr.next] = string(p)
+	lr.next = (lr.next + 1) % len(lr.lines)
+	if lr.next == 0 {
+		lr.full = true
+	}
+	lr.mu.Unlock()
+	return len(p), nil
+}
+
+// Snapshot returns the buffered lines, oldest first.
+func (lr *logRing) Snapshot() []string {
+	lr.mu.Lock()
+	defer lr.mu.Unlock()
+	var out []string
+	if lr.full {
+		out = append(out, lr.lines[lr.next:]...)
+	}
+	out = append(out, lr.lines[:lr.next]...)
+	return out
+}
+
+// InstallLogCapture tees the standard logger into the crash-report ring.
+func InstallLogCapture() {
+	log.SetOutput(io.MultiWriter(os.Stderr, recentLog))
+}
+
+// anonymizeJob returns a copy of the job with identifying fields removed,
+// keeping the technical state needed to reproduce a crash.
+func anonymizeJob(j *Job) *Job {
+	if j == nil {
+		return nil
+	}
+	clean := *j
+	clean.Name = "job"
+	clean.Customer = ""
+	clean.OrderID = ""
+	clean.Notes = ""
+	clean.Attachments = nil
+	clean.Annotations = nil
+	clean.StockCert = nil
+	clean.Tags = nil
+	clean.LimitExceptions = nil // Carry approver names; the limits themselves don't reproduce bugs.
+	return &clean
+}
+
+// CaptureCrashReport writes a crash report file and returns its path.
+func CaptureCrashReport(recovered interface{}, job *Job) (string, error) {
+	filePath := fmt.Sprintf("crash_report_%s.txt", time.Now().Format("20060102_150405"))
+	f, err := os.Create(filePath)
+	if err != nil {
+		return "", fmt.Errorf("creating crash report '%s': %w", filePath, err)
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "%s %s crash report\n", appName, appVersion)
+	fmt.Fprintf(f, "Time: %s\n", time.Now().Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(f, "Panic: %v\n\n", recovered)
+	fmt.Fprintf(f, "--- Stack ---\n%s\n", debug.Stack())
+
+	fmt.Fprintf(f, "--- Recent log ---\n")
+	for _, line := range recentLog.Snapshot() {
+		f.WriteString(line)
+	}
+
+	fmt.Fprintf(f, "\n--- Job snapshot (anonymized) ---\n")
+	if job == nil {
+		fmt.Fprintf(f, "(no active job)\n")
+	} else if data, jErr := json.MarshalIndent(anonymizeJob(job), "", "  "); jErr != nil {
+		fmt.Fprintf(f, "(snapshot failed: %v)\n", jErr)
+	} else {
+		f.Write(data)
+		f.WriteString("\n")
+	}
+	return filePath, nil
+}
+
+// recoverToCrashReport is the deferred handler guarding the UI goroutine.
+// It writes the report, tells the user where it is, and re-panics so the
+// process still exits with the original failure.
+func (ac *AppController) recoverToCrashReport() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	var job *Job
+	if ac != nil {
+		job = ac.currentJob
+	}
+	if path, err := CaptureCrashReport(r, job); err != nil {
+		log.Printf("ERROR: Failed to write crash report: %v", err)
+	} else {
+		log.Printf("ERROR: %s crashed; report written to '%s'. Please attach it to an issue.", appName, path)
+	}
+	panic(r)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+)
+
+// --- Crowning deflection simulation ---
+// Under load the ram and bed bow apart, most at mid-span: the punch
+// penetrates less at the center of a long bend than at its ends, and the
+// angle opens there — the classic "boat" part. From the per-step force
+// and the bend span this predicts the center deflection, translates it
+// into an angle deviation through the V-die geometry, and reports the
+// crowning value a compensated machine would dial in to cancel it.
+
+// crowningComplianceMMPerTM3 is the empirical frame compliance: center
+// deflection in mm per tonne of load per cubic meter of span. Calibrate
+// per machine; the default matches a mid-size brake (~0.3mm at 100t over
+// a 3m bend).
+const crowningComplianceMMPerTM3 = 0.0001
+
+// CrowningResult is the deflection prediction for one step.
+type CrowningResult struct {
+	CenterDeflectionMM float64 // Gap opened between punch and die at mid-bend.
+	AngleAtEndsDeg     float64 // The programmed target (ends see full depth).
+	AngleAtCenterDeg   float64 // Angle the center of the bend actually gets.
+	DeviationDeg       float64 // Center minus ends.
+	CompensationMM     float64 // Crowning value to dial in (equals the deflection).
+	Message            string
+}
+
+// SimulateCrowning predicts the center-to-end angle variation of one step
+// from frame deflection under its bending force.
+func SimulateCrowning(sheet *SheetMetal, step *BendStep, die *Die, pb *PressBrake) (*CrowningResult, error) {
+	if sheet == nil || step == nil || die == nil || pb == nil {
+		return nil, fmt.Errorf("sheet, step, die or press brake is nil for crowning simulation")
+	}
+	if die.VOpening <= 0 {
+		return nil, fmt.Errorf("die '%s' has no V opening for crowning simulation", die.Name)
+	}
+	tonnes, err := RequiredTonnesForStep(sheet, step, die, pb)
+	if err != nil {
+		return nil, err
+	}
+
+	spanM := sheet.Width / 1000.0
+	deflection := crowningComplianceMMPerTM3 * tonnes * spanM * spanM * spanM
+
+	depth := penetrationForAngle(step.TargetAngle, die.VOpening)
+	centerAngle := angleForPenetration(depth-deflection, die.VOpening)
+
+	res := &CrowningResult{
+		CenterDeflectionMM: deflection,
+		AngleAtEndsDeg:     step.TargetAngle,
+		AngleAtCenterDeg:   centerAngle,
+		DeviationDeg:       centerAngle - step.TargetAngle,
+		CompensationMM:     deflection,
+	}
+	if res.DeviationDeg >= 0.25 {
+		res.Message = fmt.Sprintf(
+			"Crowning: center opens %.2f° vs ends (%.2f° -> %.2f°) from %.2fmm deflection; compensate with %.2fmm crowning.",
+			res.DeviationDeg, res.AngleAtEndsDeg, res.AngleAtCenterDeg, deflection, res.CompensationMM)
+	}
+	return res, nil
+}
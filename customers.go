@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// --- Customer tolerance profiles ---
+// Different customers accept different deviations. Storing a tolerance
+// profile per customer and linking jobs to a customer means the right
+// limits flow into validation and capability checks automatically instead
+// of being re-entered per job.
+
+// ToleranceProfile holds the default acceptance limits for a customer.
+type ToleranceProfile struct {
+	AngleDeg       float64 // +/- allowed angle deviation (degrees).
+	FlangeLengthMM float64 // +/- allowed flange length deviation (mm).
+}
+
+// defaultTolerances applies when a job has no customer, or the customer has
+// no profile: the common "general sheet metal" values.
+var defaultTolerances = ToleranceProfile{AngleDeg: 1.0, FlangeLengthMM: 0.5}
+
+// Customer pairs a name with its default tolerance profile.
+type Customer struct {
+	Name       string
+	Tolerances ToleranceProfile
+}
+
+// CustomerRegistry stores known customers. Safe for concurrent use.
+type CustomerRegistry struct {
+	mu        sync.RWMutex
+	customers map[string]*Customer
+}
+
+// customers is the application-wide registry.
+var customers = &CustomerRegistry{customers: make(map[string]*Customer)}
+
+// GetCustomerRegistry returns the application-wide customer registry.
+func GetCustomerRegistry() *CustomerRegistry { return customers }
+
+// Register adds or replaces a customer entry.
+func (cr *CustomerRegistry) Register(c *Customer) error {
+	if c == nil || c.Name == "" {
+		return fmt.Errorf("customer must have a name")
+	}
+	cr.mu.Lock()
+	cr.customers[c.Name] = c
+	cr.mu.Unlock()
+	log.Printf("INFO: Customer '%s' registered (angle ±%.2f°, flange ±%.2fmm).",
+		c.Name, c.Tolerances.AngleDeg, c.Tolerances.FlangeLengthMM)
+	return nil
+}
+
+// Get looks up a customer by name.
+func (cr *CustomerRegistry) Get(name string) (*Customer, bool) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+	c, ok := cr.customers[name]
+	return c, ok
+}
+
+// TolerancesForJob resolves the tolerance profile that applies to a job:
+// the linked customer's profile if one exists, the defaults otherwise.
+func TolerancesForJob(j *Job) ToleranceProfile {
+	if j != nil && j.Customer != "" {
+		if c, ok := customers.Get(j.Customer); ok {
+			return c.Tolerances
+		}
+		log.Printf("WARNING: Job '%s' references unknown customer '%s'; using default tolerances.", j.Name, j.Customer)
+	}
+	return defaultTolerances
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync"
+)
+
+// --- Loadable bend deduction tables ---
+// A shop's proven deduction numbers beat any formula: they were measured
+// on this machine with this tooling. Tables import from CSV keyed by
+// material, thickness, V opening and angle; wherever the simulation needs
+// an allowance/deduction pair it asks the table first and only falls back
+// to the K-factor estimate when no entry matches.
+
+// DeductionEntry is one measured allowance/deduction record.
+type DeductionEntry struct {
+	Material    string
+	ThicknessMM float64
+	VOpeningMM  float64
+	AngleDeg    float64
+	AllowanceMM float64
+	DeductionMM float64
+}
+
+// Matching tolerances for table lookup.
+const (
+	dedTolThicknessMM = 0.05
+	dedTolVOpeningMM  = 0.5
+	dedTolAngleDeg    = 0.5
+)
+
+// DeductionTable holds the imported entries.
+type DeductionTable struct {
+	mu      sync.RWMutex
+	entries []DeductionEntry
+}
+
+// deductionTable is the application-wide table.
+var deductionTable = &DeductionTable{}
+
+// GetDeductionTable returns the application-wide table.
+func GetDeductionTable() *DeductionTable { return deductionTable }
+
+// Len returns the number of loaded entries.
+func (dt *DeductionTable) Len() int {
+	dt.mu.RLock()
+	defer dt.mu.RUnlock()
+	return len(dt.entries)
+}
+
+// Clear drops all loaded entries (the formula takes over again).
+func (dt *DeductionTable) Clear() {
+	dt.mu.Lock()
+	dt.entries = nil
+	dt.mu.Unlock()
+}
+
+// LoadDeductionTableCSV imports entries from a CSV file with the header
+// material,thickness_mm,v_opening_mm,angle_deg,allowance_mm,deduction_mm.
+// Bad rows are skipped with a warning; the return value is how many
+// entries were added.
+func LoadDeductionTableCSV(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("opening deduction table '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("parsing deduction table '%s': %w", path, err)
+	}
+	if len(records) < 2 {
+		return 0, fmt.Errorf("deduction table '%s' has no data rows", path)
+	}
+
+	col := make(map[string]int)
+	for i, name := range records[0] {
+		col[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	for _, want := range []string{"material", "thickness_mm", "angle_deg", "deduction_mm"} {
+		if _, ok := col[want]; !ok {
+			return 0, fmt.Errorf("deduction table '%s' is missing column '%s'", path, want)
+		}
+	}
+	num := func(rec []string, name string) (float64, bool) {
+		i, ok := col[name]
+		if !ok || i >= len(rec) {
+			return 0, false
+		}
+		v, convErr := ParseUserFloat(rec[i])
+		return v, convErr == nil
+	}
+
+	added := 0
+	for n, rec := range records[1:] {
+		mat := ""
+		if i, ok := col["material"]; ok && i < len(rec) {
+			mat = strings.TrimSpace(rec[i])
+		}
+		t, tOK := num(rec, "thickness_mm")
+		ang, aOK := num(rec, "angle_deg")
+		bd, dOK := num(rec, "deduction_mm")
+		if mat == "" || !tOK || !aOK || !dOK {
+			log.Printf("WARNING: Skipping deduction table row %d: missing material/thickness/angle/deduction.", n+2)
+			continue
+		}
+		v, _ := num(rec, "v_opening_mm")
+		ba, baOK := num(rec, "allowance_mm")
+		if !baOK {
+			ba = 0
+		}
+		deductionTable.mu.Lock()
+		deductionTable.entries = append(deductionTable.entries, DeductionEntry{
+			Material: mat, ThicknessMM: t, VOpeningMM: v, AngleDeg: ang, AllowanceMM: ba, DeductionMM: bd,
+		})
+		deductionTable.mu.Unlock()
+		added++
+	}
+	log.Printf("INFO: Loaded %d deduction entrie(s) from '%s' (table now %d).", added, path, deductionTable.Len())
+	return added, nil
+}
+
+// LookupDeduction finds the closest entry within tolerance for the given
+// conditions. A vOpening of 0 means "unknown": the V criterion is skipped.
+func LookupDeduction(material string, thickness, vOpening, angle float64) (DeductionEntry, bool) {
+	deductionTable.mu.RLock()
+	defer deductionTable.mu.RUnlock()
+
+	best := DeductionEntry{}
+	bestDist := math.MaxFloat64
+	found := false
+	for _, e := range deductionTable.entries {
+		if !strings.EqualFold(e.Material, material) {
+			continue
+		}
+		if math.Abs(e.ThicknessMM-thickness) > dedTolThicknessMM {
+			continue
+		}
+		if math.Abs(e.AngleDeg-angle) > dedTolAngleDeg {
+			continue
+		}
+		if vOpening > 0 && math.Abs(e.VOpeningMM-vOpening) > dedTolVOpeningMM {
+			continue
+		}
+		dist := math.Abs(e.ThicknessMM-thickness) + math.Abs(e.AngleDeg-angle)
+		if vOpening > 0 {
+			dist += math.Abs(e.VOpeningMM - vOpening)
+		}
+		if dist < bestDist {
+			best, bestDist, found = e, dist, true
+		}
+	}
+	return best, found
+}
+
+// allowanceWithTable returns the allowance/deduction pair for a bend,
+// preferring a table entry and falling back to the K-factor formula. An
+// entry without a measured allowance keeps the formula's allowance.
+func allowanceWithTable(material MaterialName, thickness, vOpening, angleDeg, radius float64) (allowance, deduction float64) {
+	allowance, deduction = allowanceForAngle(angleDeg, radius, thickness)
+	if e, ok := LookupDeduction(string(material), thickness, vOpening, angleDeg); ok {
+		deduction = e.DeductionMM
+		if e.AllowanceMM != 0 {
+			allowance = e.AllowanceMM
+		}
+	}
+	return allowance, deduction
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// --- Die selection comparison ---
+// Picking a V opening is a trade-off: a wider die bends with less force
+// and marks less but leaves a larger radius, needs longer flanges and
+// springs back more. Before committing a die for a step, this view
+// tabulates those consequences for every die in the library so the choice
+// is made on numbers instead of habit.
+
+// DieComparisonRow is one die's predicted consequences for a step.
+type DieComparisonRow struct {
+	DieName       string
+	VOpening      float64
+	TonnageT      float64 // Total force over the bend width (tonnes).
+	InnerRadius   float64 // Natural air-bend radius the part will take (mm).
+	MinFlange     float64 // Shortest flange the die supports (mm).
+	SpringbackDeg float64 // Predicted elastic recovery (angle accuracy proxy).
+}
+
+// CompareDiesForStep evaluates every die in the library against one step,
+// sorted by V opening.
+func CompareDiesForStep(sheet *SheetMetal, step *BendStep, tm *ToolingManager, pb *PressBrake) ([]DieComparisonRow, error) {
+	if sheet == nil || step == nil {
+		return nil, fmt.Errorf("sheet or step is nil for die comparison")
+	}
+	if tm == nil {
+		return nil, fmt.Errorf("no tooling manager for die comparison")
+	}
+
+	var punch *Punch
+	if pb != nil {
+		punch = pb.GetCurrentPunch()
+	}
+
+	var rows []DieComparisonRow
+	for _, name := range tm.GetDieNames() {
+		die, ok := tm.GetDieByName(name)
+		if !ok || die.VOpening <= 0 {
+			continue
+		}
+		row := DieComparisonRow{
+			DieName:     die.Name,
+			VOpening:    die.VOpening,
+			InnerRadius: die.VOpening / 6.0, // Natural air-bend radius.
+			MinFlange:   MinFlangeForDie(die),
+		}
+		if tonnes, err := RequiredTonnesForStep(sheet, step, die, pb); err == nil {
+			row.TonnageT = tonnes
+		}
+		// Springback against the radius this die would actually produce.
+		probe := *step
+		if row.InnerRadius > probe.Radius {
+			probe.Radius = row.InnerRadius
+		}
+		if sbk, err := PredictSpringback(sheet, &probe, punch); err == nil {
+			row.SpringbackDeg = sbk.SpringbackDeg
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(a, b int) bool { return rows[a].VOpening < rows[b].VOpening })
+	return rows, nil
+}
+
+// FormatDieComparison renders the comparison as a fixed-width table.
+func FormatDieComparison(step *BendStep, rows []DieComparisonRow) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Die comparison for bend at %.1fmm, %.1f°:\n", step.Position, step.TargetAngle))
+	sb.WriteString("Die            V(mm)  Tonnage  R(in)  MinFlange  Springback\n")
+	for _, r := range rows {
+		sb.WriteString(fmt.Sprintf("%-14s %5.0f  %6.1ft %6.2f %8.1fmm %9.2f°\n",
+			r.DieName, r.VOpening, r.TonnageT, r.InnerRadius, r.MinFlange, r.SpringbackDeg))
+	}
+	return sb.String()
+}
+
+// showDieComparison opens the comparison for the selected step (or the
+// last step when none is selected) in a dialog.
+func (ac *AppController) showDieComparison() {
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil || len(ac.currentJob.Steps) == 0 {
+		ac.updateStatus("No bend steps to compare dies for.", true)
+		return
+	}
+	idx := ac.selectedStepIdx
+	if idx < 0 || idx >= len(ac.currentJob.Steps) {
+		idx = len(ac.currentJob.Steps) - 1
+	}
+	step := ac.currentJob.Steps[idx]
+	rows, err := CompareDiesForStep(ac.currentJob.Sheet, step, ac.toolingManager, ac.pressBrake)
+	if err != nil {
+		ac.updateStatus(fmt.Sprintf("Die comparison error: %v", err), true)
+		return
+	}
+	ac.showConfirmDialog("Die Comparison", FormatDieComparison(step, rows), nil, nil)
+}
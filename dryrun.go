@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+)
+
+// --- Dry-run diff on data changes ---
+// Editing a material or tool definition can silently change proven programs.
+// The dry-run diff snapshots the simulation summary of the affected jobs,
+// applies the edit, re-summarizes, and reports which jobs moved beyond the
+// configured thresholds.
+
+// DryRunThresholds defines how much change is worth reporting.
+type DryRunThresholds struct {
+	DevelopedLengthMM float64 // Report blank length changes above this.
+	TonnageT          float64 // Report tonnage changes above this.
+}
+
+// defaultDryRunThresholds suit typical shop tolerances.
+var defaultDryRunThresholds = DryRunThresholds{DevelopedLengthMM: 0.1, TonnageT: 0.5}
+
+// JobDelta describes one reported change on one job.
+type JobDelta struct {
+	JobName string
+	Field   string // "developed length" or "tonnage".
+	Before  float64
+	After   float64
+}
+
+// String formats the delta for logs and reports.
+func (d JobDelta) String() string {
+	return fmt.Sprintf("job '%s': %s changed %.3f -> %.3f", d.JobName, d.Field, d.Before, d.After)
+}
+
+// DryRunDiff snapshots the given jobs, applies the data edit, and returns
+// the deltas exceeding the thresholds. Zero-valued thresholds fall back to
+// the defaults. The edit is applied exactly once, whether or not any job
+// can be summarized.
+func DryRunDiff(jobs []*Job, pb *PressBrake, edit func(), thresholds DryRunThresholds) ([]JobDelta, error) {
+	if edit == nil {
+		return nil, fmt.Errorf("no edit to apply in dry-run diff")
+	}
+	if thresholds.DevelopedLengthMM <= 0 {
+		thresholds.DevelopedLengthMM = defaultDryRunThresholds.DevelopedLengthMM
+	}
+	if thresholds.TonnageT <= 0 {
+		thresholds.TonnageT = defaultDryRunThresholds.TonnageT
+	}
+
+	before := make(map[string]*SimulationSummary, len(jobs))
+	for _, j := range jobs {
+		if j == nil {
+			continue
+		}
+		if sum, err := SummarizeJobSimulation(j, pb); err == nil {
+			before[j.Name] = sum
+		}
+	}
+
+	edit()
+
+	var deltas []JobDelta
+	for _, j := range jobs {
+		if j == nil {
+			continue
+		}
+		prev, ok := before[j.Name]
+		if !ok {
+			continue
+		}
+		after, err := SummarizeJobSimulation(j, pb)
+		if err != nil {
+			continue
+		}
+		if math.Abs(after.DevelopedLengthMM-prev.DevelopedLengthMM) > thresholds.DevelopedLengthMM {
+			deltas = append(deltas, JobDelta{JobName: j.Name, Field: "developed length", Before: prev.DevelopedLengthMM, After: after.DevelopedLengthMM})
+		}
+		if math.Abs(after.MaxTonnage-prev.MaxTonnage) > thresholds.TonnageT {
+			deltas = append(deltas, JobDelta{JobName: j.Name, Field: "tonnage", Before: prev.MaxTonnage, After: after.MaxTonnage})
+		}
+	}
+	return deltas, nil
+}
+
+// DryRunDiffAsync runs DryRunDiff in the background and delivers the result
+// through report, so a data edit in the UI stays responsive while a large
+// job library is re-simulated.
+func DryRunDiffAsync(jobs []*Job, pb *PressBrake, edit func(), thresholds DryRunThresholds, report func([]JobDelta, error)) {
+	go func() {
+		deltas, err := DryRunDiff(jobs, pb, edit, thresholds)
+		if err != nil {
+			log.Printf("ERROR: Dry-run diff failed: %v", err)
+		} else if len(deltas) > 0 {
+			log.Printf("WARNING: Data edit changed %d saved job result(s):", len(deltas))
+			for _, d := range deltas {
+				log.Printf("  %s", d.String())
+			}
+		} else {
+			log.Printf("INFO: Dry-run diff: no saved jobs changed beyond thresholds.")
+		}
+		if report != nil {
+			report(deltas, err)
+		}
+	}()
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// --- Near-duplicate job detection ---
+// Libraries bloat because nobody searches before re-programming a part
+// that already exists. On import/save the simulator compares the new job
+// against the library: same blank within tolerance and a similar bend
+// table means the proven existing program should probably be used instead.
+
+// Duplicate-match tolerances. Deliberately loose: the point is to surface
+// candidates for a human decision, not to prove parts identical.
+const (
+	dupBlankTolMM    = 1.0  // Blank length/width/thickness tolerance.
+	dupPositionTolMM = 2.0  // Bend position tolerance per step.
+	dupAngleTolDeg   = 3.0  // Target angle tolerance per step.
+	dupMinScore      = 0.75 // Minimum similarity to report a match.
+)
+
+// DuplicateMatch is one near-duplicate candidate from the library.
+type DuplicateMatch struct {
+	Job        *Job
+	Similarity float64 // 0..1, 1 = effectively identical.
+	Reason     string
+}
+
+// blanksMatch reports whether two sheets have the same blank within
+// tolerance, including the flipped (rotated 180°) orientation.
+func blanksMatch(a, b *SheetMetal) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if math.Abs(a.Thickness-b.Thickness) > dupBlankTolMM/10 || a.Material.Name != b.Material.Name {
+		return false
+	}
+	return math.Abs(a.OriginalLength-b.OriginalLength) <= dupBlankTolMM &&
+		math.Abs(a.Width-b.Width) <= dupBlankTolMM
+}
+
+// bendTableSimilarity scores how closely two step lists match, 0..1.
+// Steps are compared in sequence order; differing step counts cost
+// proportionally.
+func bendTableSimilarity(a, b []*BendStep) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1.0
+	}
+	longer := len(a)
+	if len(b) > longer {
+		longer = len(b)
+	}
+	matched := 0
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == nil || b[i] == nil {
+			continue
+		}
+		if math.Abs(a[i].Position-b[i].Position) <= dupPositionTolMM &&
+			math.Abs(a[i].TargetAngle-b[i].TargetAngle) <= dupAngleTolDeg &&
+			a[i].Direction == b[i].Direction {
+			matched++
+		}
+	}
+	return float64(matched) / float64(longer)
+}
+
+// FindDuplicateJobs returns library jobs that look like near-duplicates of
+// candidate, best match first. The candidate itself (same pointer) is
+// skipped.
+func FindDuplicateJobs(candidate *Job, library []*Job) ([]DuplicateMatch, error) {
+	if candidate == nil || candidate.Sheet == nil {
+		return nil, fmt.Errorf("candidate job or sheet is nil for duplicate detection")
+	}
+
+	var matches []DuplicateMatch
+	for _, existing := range library {
+		if existing == nil || existing == candidate || existing.Sheet == nil {
+			continue
+		}
+		if !blanksMatch(candidate.Sheet, existing.Sheet) {
+			continue
+		}
+		score := bendTableSimilarity(candidate.Steps, existing.Steps)
+		if score < dupMinScore {
+			continue
+		}
+		reason := fmt.Sprintf("same %.0fx%.0fx%.1fmm %s blank, %.0f%% of bends match",
+			existing.Sheet.OriginalLength, existing.Sheet.Width, existing.Sheet.Thickness,
+			existing.Sheet.Material.Name, score*100)
+		matches = append(matches, DuplicateMatch{Job: existing, Similarity: score, Reason: reason})
+	}
+	sort.Slice(matches, func(i, k int) bool { return matches[i].Similarity > matches[k].Similarity })
+	return matches, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+)
+
+// --- DXF export ---
+// CAD/CAM wants geometry back, not pictures: the exporter writes the
+// simulated cross-section (legs and real arcs) and the developed flat
+// pattern with its bend lines as a minimal R12 entities-only DXF, in mm.
+// Layers separate the views and the bend senses so downstream systems
+// can filter them.
+
+// DXF layer names.
+const (
+	dxfLayerProfile  = "PROFILE"
+	dxfLayerFlat     = "FLAT"
+	dxfLayerBendUp   = "BEND_UP"
+	dxfLayerBendDown = "BEND_DOWN"
+)
+
+// dxfFlatGapMM separates the flat pattern from the profile view.
+const dxfFlatGapMM = 50.0
+
+// dxfWriter accumulates entity records.
+type dxfWriter struct {
+	sb strings.Builder
+}
+
+func (w *dxfWriter) group(code int, value string) {
+	w.sb.WriteString(fmt.Sprintf("%d\n%s\n", code, value))
+}
+
+func (w *dxfWriter) line(layer string, color int, x1, y1, x2, y2 float64) {
+	w.group(0, "LINE")
+	w.group(8, layer)
+	w.group(62, fmt.Sprintf("%d", color))
+	w.group(10, fmt.Sprintf("%.4f", x1))
+	w.group(20, fmt.Sprintf("%.4f", y1))
+	w.group(11, fmt.Sprintf("%.4f", x2))
+	w.group(21, fmt.Sprintf("%.4f", y2))
+}
+
+// arc writes a DXF ARC, which always runs counter-clockwise from the
+// start to the end angle (degrees).
+func (w *dxfWriter) arc(layer string, color int, cx, cy, r, startDeg, endDeg float64) {
+	w.group(0, "ARC")
+	w.group(8, layer)
+	w.group(62, fmt.Sprintf("%d", color))
+	w.group(10, fmt.Sprintf("%.4f", cx))
+	w.group(20, fmt.Sprintf("%.4f", cy))
+	w.group(40, fmt.Sprintf("%.4f", r))
+	w.group(50, fmt.Sprintf("%.4f", startDeg))
+	w.group(51, fmt.Sprintf("%.4f", endDeg))
+}
+
+func (w *dxfWriter) text(layer string, x, y, height float64, s string) {
+	w.group(0, "TEXT")
+	w.group(8, layer)
+	w.group(10, fmt.Sprintf("%.4f", x))
+	w.group(20, fmt.Sprintf("%.4f", y))
+	w.group(40, fmt.Sprintf("%.2f", height))
+	w.group(1, s)
+}
+
+// dxfColorForDirection maps a bend sense to an AutoCAD color index,
+// keeping the hue of the configured line style (green up, red down by
+// default).
+func dxfColorForDirection(dir BendDirection) int {
+	if styleForDirection(dir).Dashed {
+		return 1 // Red.
+	}
+	return 3 // Green.
+}
+
+// GenerateDXF writes the folded cross-section and the developed flat
+// pattern (with direction-layered bend lines) of the job.
+func GenerateDXF(j *Job, filePath string) error {
+	if j == nil || j.Sheet == nil {
+		return fmt.Errorf("job or sheet is nil for DXF export")
+	}
+	cs, err := BuildCrossSection(j.Sheet)
+	if err != nil {
+		return err
+	}
+
+	var w dxfWriter
+	w.group(0, "SECTION")
+	w.group(2, "ENTITIES")
+
+	// Formed cross-section at the origin.
+	for _, el := range cs.Elements {
+		if el.Kind == ProfileLine {
+			w.line(dxfLayerProfile, 7, el.From.X, el.From.Y, el.To.X, el.To.Y)
+			continue
+		}
+		a1 := math.Atan2(el.From.Y-el.Center.Y, el.From.X-el.Center.X) * 180 / math.Pi
+		a2 := math.Atan2(el.To.Y-el.Center.Y, el.To.X-el.Center.X) * 180 / math.Pi
+		if el.SweepRad < 0 { // DXF arcs run counter-clockwise.
+			a1, a2 = a2, a1
+		}
+		w.arc(dxfLayerProfile, 7, el.Center.X, el.Center.Y, el.RadiusMM, a1, a2)
+	}
+
+	// Flat pattern below the profile: blank outline plus bend lines.
+	csMin, _ := cs.Bounds()
+	flatTop := csMin.Y - dxfFlatGapMM
+	flatBottom := flatTop - j.Sheet.Width
+	w.line(dxfLayerFlat, 7, 0, flatTop, j.Sheet.OriginalLength, flatTop)
+	w.line(dxfLayerFlat, 7, j.Sheet.OriginalLength, flatTop, j.Sheet.OriginalLength, flatBottom)
+	w.line(dxfLayerFlat, 7, j.Sheet.OriginalLength, flatBottom, 0, flatBottom)
+	w.line(dxfLayerFlat, 7, 0, flatBottom, 0, flatTop)
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		layer := dxfLayerBendUp
+		if step.Direction == BendDirectionDown {
+			layer = dxfLayerBendDown
+		}
+		w.line(layer, dxfColorForDirection(step.Direction), step.Position, flatTop, step.Position, flatBottom)
+		w.text(layer, step.Position+1, flatBottom-5, 3,
+			fmt.Sprintf("%s %s deg R%s", step.Direction, FormatProgramAngle(step.TargetAngle), FormatProgramPosition(step.Radius)))
+	}
+	w.text(dxfLayerFlat, 0, flatBottom-12, 3, fmt.Sprintf("Flat pattern: %s", j.Name))
+
+	w.group(0, "ENDSEC")
+	w.group(0, "EOF")
+
+	if err := os.WriteFile(filePath, []byte(w.sb.String()), 0644); err != nil {
+		return fmt.Errorf("writing DXF '%s': %w", filePath, err)
+	}
+	log.Printf("INFO: DXF export for job '%s' written to '%s' (%d element(s)).", j.Name, filePath, len(cs.Elements))
+	return nil
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// --- Bulk DXF import ---
+// Shops migrating to the simulator usually have a folder full of DXF flat
+// patterns. The bulk importer scans such a folder, builds a candidate job
+// per file (blank size from the outline bounding box, bend steps from
+// lines on a BEND layer), and reports which files imported cleanly and
+// which need a human to look at them.
+
+// dxfBendLayer is the layer name the importer treats as bend lines. Lines
+// on any other layer contribute to the blank outline only.
+const dxfBendLayer = "BEND"
+
+// dxfEntity is one LINE entity pulled from a DXF file.
+type dxfEntity struct {
+	Layer          string
+	X1, Y1, X2, Y2 float64
+}
+
+// DXFImportResult records the outcome for one scanned file.
+type DXFImportResult struct {
+	Path    string
+	Job     *Job   // Non-nil when the file imported cleanly.
+	Problem string // Why the file needs manual attention; empty on success.
+}
+
+// parseDXFLines extracts LINE entities from a DXF file. The parser reads
+// the group-code/value pair stream directly; it deliberately understands
+// only what the importer needs and skips everything else.
+func parseDXFLines(path string) ([]dxfEntity, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening DXF file: %w", err)
+	}
+	defer f.Close()
+
+	var entities []dxfEntity
+	var current *dxfEntity
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		code := strings.TrimSpace(scanner.Text())
+		if !scanner.Scan() {
+			break
+		}
+		value := strings.TrimSpace(scanner.Text())
+
+		if code == "0" {
+			if current != nil {
+				entities = append(entities, *current)
+				current = nil
+			}
+			if value == "LINE" {
+				current = &dxfEntity{}
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		switch code {
+		case "8":
+			current.Layer = strings.ToUpper(value)
+		case "10", "20", "11", "21":
+			v, convErr := strconv.ParseFloat(value, 64)
+			if convErr != nil {
+				return nil, fmt.Errorf("bad coordinate %q for group %s: %w", value, code, convErr)
+			}
+			switch code {
+			case "10":
+				current.X1 = v
+			case "20":
+				current.Y1 = v
+			case "11":
+				current.X2 = v
+			case "21":
+				current.Y2 = v
+			}
+		}
+	}
+	if current != nil {
+		entities = append(entities, *current)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading DXF file: %w", err)
+	}
+	return entities, nil
+}
+
+// jobFromDXF builds a candidate job from the parsed entities of one file.
+// Bend lines must run parallel to the Y axis (across the blank); anything
+// else is flagged for manual attention.
+func jobFromDXF(name string, entities []dxfEntity, thickness float64, material MaterialDetails) (*Job, string) {
+	if len(entities) == 0 {
+		return nil, "no LINE entities found (polylines/arcs are not imported)"
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	var bendXs []float64
+	for _, e := range entities {
+		minX = math.Min(minX, math.Min(e.X1, e.X2))
+		maxX = math.Max(maxX, math.Max(e.X1, e.X2))
+		minY = math.Min(minY, math.Min(e.Y1, e.Y2))
+		maxY = math.Max(maxY, math.Max(e.Y1, e.Y2))
+		if e.Layer == dxfBendLayer {
+			if math.Abs(e.X1-e.X2) > 0.01 {
+				return nil, fmt.Sprintf("bend line from (%.1f,%.1f) to (%.1f,%.1f) is not parallel to the Y axis", e.X1, e.Y1, e.X2, e.Y2)
+			}
+			bendXs = append(bendXs, e.X1)
+		}
+	}
+
+	length, width := maxX-minX, maxY-minY
+	sheet, err := NewSheetMetal(name, length, width, thickness, material)
+	if err != nil {
+		return nil, fmt.Sprintf("blank size %.1fx%.1fmm rejected: %v", length, width, err)
+	}
+	job := &Job{Name: name, Sheet: sheet}
+	for i, x := range bendXs {
+		job.Steps = append(job.Steps, &BendStep{
+			SequenceOrder: i + 1,
+			Position:      x - minX,
+			TargetAngle:   90, // Flat patterns carry no angle; default and review.
+			Radius:        sheet.GetMinBendRadius(),
+			Direction:     BendDirectionUp,
+		})
+	}
+	return job, ""
+}
+
+// ImportDXFFolder scans dir for .dxf files and returns one result per file,
+// in filename order. Files that fail stay in the report with their problem
+// instead of aborting the batch.
+func ImportDXFFolder(dir string, thickness float64, material MaterialDetails) ([]DXFImportResult, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.dxf"))
+	if err != nil {
+		return nil, fmt.Errorf("scanning folder '%s': %w", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no .dxf files found in '%s'", dir)
+	}
+
+	results := make([]DXFImportResult, 0, len(paths))
+	clean := 0
+	for _, path := range paths {
+		res := DXFImportResult{Path: path}
+		entities, parseErr := parseDXFLines(path)
+		if parseErr != nil {
+			res.Problem = parseErr.Error()
+		} else {
+			name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+			res.Job, res.Problem = jobFromDXF(name, entities, thickness, material)
+		}
+		if res.Problem == "" {
+			clean++
+		} else {
+			log.Printf("WARNING: DXF import: '%s' needs attention: %s", path, res.Problem)
+		}
+		results = append(results, res)
+	}
+	log.Printf("INFO: DXF folder import: %d/%d files imported cleanly from '%s'.", clean, len(results), dir)
+	return results, nil
+}
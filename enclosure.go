@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Electrical enclosure wizard ---
+// Enclosure bodies and doors are the bread-and-butter multi-bend part:
+// four wrapped sides with return flanges, a door with safety hems, and
+// corner notches so the flanges clear each other. The wizard turns
+// overall dimensions into the body and door jobs plus the notch list the
+// laser needs in the blank outline.
+
+// EnclosureSpec is the overall enclosure as dimensioned on the drawing.
+type EnclosureSpec struct {
+	HeightMM    float64 // Overall body height (the bend-line direction).
+	WidthMM     float64 // Overall body width.
+	DepthMM     float64 // Side depth (the wrapped flanges).
+	ReturnMM    float64 // Return flange folded back inside from each side.
+	DoorGapMM   float64 // Clearance per door edge against the body opening.
+	ThicknessMM float64
+	Material    MaterialDetails
+}
+
+// CornerNotch is one rectangular cut the blank needs before bending.
+type CornerNotch struct {
+	X, Y     float64 // Lower-left corner on the flat, from the blank origin.
+	WidthMM  float64
+	HeightMM float64
+}
+
+// EnclosureParts is the wizard output: both jobs and the notch list for
+// the body blank.
+type EnclosureParts struct {
+	Body        *Job
+	Door        *Job
+	BodyNotches []CornerNotch
+}
+
+// GenerateEnclosure builds the body and door jobs from the spec.
+func GenerateEnclosure(name string, spec EnclosureSpec) (*EnclosureParts, error) {
+	if spec.DepthMM <= spec.ReturnMM {
+		return nil, fmt.Errorf("depth %.1fmm must exceed the return flange %.1fmm", spec.DepthMM, spec.ReturnMM)
+	}
+	if spec.DoorGapMM <= 0 {
+		spec.DoorGapMM = 1.0
+	}
+
+	parts := &EnclosureParts{}
+
+	// Body: width wraps into two sides and two returns.
+	// Blank across: return | depth | width | depth | return.
+	bodyBlank := spec.WidthMM + 2*spec.DepthMM + 2*spec.ReturnMM
+	bodySheet, err := NewSheetMetal(name+"-body", bodyBlank, spec.HeightMM, spec.ThicknessMM, spec.Material)
+	if err != nil {
+		return nil, fmt.Errorf("enclosure body sheet: %w", err)
+	}
+	body, err := NewJob(name+"-body", bodySheet)
+	if err != nil {
+		return nil, err
+	}
+	r := spec.ThicknessMM // Inner radius ~ thickness suits typical enclosures.
+	positions := []float64{
+		spec.ReturnMM,
+		spec.ReturnMM + spec.DepthMM,
+		spec.ReturnMM + spec.DepthMM + spec.WidthMM,
+		spec.ReturnMM + 2*spec.DepthMM + spec.WidthMM,
+	}
+	// Returns first (they would be unreachable after the sides come up).
+	order := []int{0, 3, 1, 2}
+	for seq, idx := range order {
+		body.Steps = append(body.Steps, &BendStep{
+			SequenceOrder: seq + 1,
+			Position:      positions[idx],
+			TargetAngle:   90,
+			Radius:        r,
+			Direction:     BendDirectionUp,
+		})
+	}
+
+	// The side flanges and returns collide at the blank corners unless the
+	// corners are notched depth+return deep over the return width.
+	notchDepth := spec.DepthMM + spec.ReturnMM
+	for _, x := range []float64{0, bodyBlank - notchDepth} {
+		for _, y := range []float64{0, spec.HeightMM - spec.ReturnMM} {
+			parts.BodyNotches = append(parts.BodyNotches, CornerNotch{
+				X: x, Y: y, WidthMM: notchDepth, HeightMM: spec.ReturnMM,
+			})
+		}
+	}
+
+	// Door: opening size minus clearance, with a safety hem on each of the
+	// two long edges.
+	doorW := spec.WidthMM - 2*spec.DoorGapMM
+	doorH := spec.HeightMM - 2*spec.DoorGapMM
+	const hem = 6.0 // Safety hem width on each door edge.
+	doorBlank := doorW + 2*hem
+	doorSheet, err := NewSheetMetal(name+"-door", doorBlank, doorH, spec.ThicknessMM, spec.Material)
+	if err != nil {
+		return nil, fmt.Errorf("enclosure door sheet: %w", err)
+	}
+	door, err := NewJob(name+"-door", doorSheet)
+	if err != nil {
+		return nil, err
+	}
+	doorJC := &JobController{currentJob: door}
+	if _, err := doorJC.AddHemToCurrentJob(hem, BendDirectionUp); err != nil {
+		return nil, fmt.Errorf("door hem (left): %w", err)
+	}
+	if _, err := doorJC.AddHemToCurrentJob(doorBlank-hem, BendDirectionUp); err != nil {
+		return nil, fmt.Errorf("door hem (right): %w", err)
+	}
+
+	parts.Body = body
+	parts.Door = door
+	log.Printf("INFO: Generated enclosure '%s': body blank %.1fx%.1fmm (%d notches), door blank %.1fx%.1fmm.",
+		name, bodyBlank, spec.HeightMM, len(parts.BodyNotches), doorBlank, doorH)
+	return parts, nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// --- ERP work order import ---
+// Shops schedule in their ERP; re-typing part data here is wasted work.
+// Work orders can be imported from a CSV export or pulled from a simple
+// REST endpoint, creating placeholder jobs in the queue. The order ID
+// stays on the job so exports can link results back to the ERP.
+
+// WorkOrder is one order line from the ERP.
+type WorkOrder struct {
+	OrderID    string `json:"order_id"`
+	PartNumber string `json:"part_number"`
+	Quantity   int    `json:"quantity"`
+	DueDate    string `json:"due_date"` // ISO date as exported; parsed lazily.
+	Material   string `json:"material"`
+}
+
+// ImportWorkOrdersCSV reads work orders from a CSV file with a header row:
+// order_id,part_number,quantity,due_date,material.
+func ImportWorkOrdersCSV(path string) ([]WorkOrder, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening work order CSV: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading work order CSV header: %w", err)
+	}
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[name] = i
+	}
+	for _, required := range []string{"order_id", "part_number", "quantity"} {
+		if _, ok := col[required]; !ok {
+			return nil, fmt.Errorf("work order CSV is missing required column '%s'", required)
+		}
+	}
+
+	field := func(rec []string, name string) string {
+		if i, ok := col[name]; ok && i < len(rec) {
+			return rec[i]
+		}
+		return ""
+	}
+
+	var orders []WorkOrder
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading work order CSV row: %w", err)
+		}
+		qty, qErr := strconv.Atoi(field(rec, "quantity"))
+		if qErr != nil {
+			log.Printf("WARNING: Skipping work order '%s': bad quantity '%s'.", field(rec, "order_id"), field(rec, "quantity"))
+			continue
+		}
+		orders = append(orders, WorkOrder{
+			OrderID:    field(rec, "order_id"),
+			PartNumber: field(rec, "part_number"),
+			Quantity:   qty,
+			DueDate:    field(rec, "due_date"),
+			Material:   field(rec, "material"),
+		})
+	}
+	log.Printf("INFO: Imported %d work order(s) from '%s'.", len(orders), path)
+	return orders, nil
+}
+
+// FetchWorkOrdersREST pulls work orders from a REST endpoint returning a
+// JSON array of work order objects.
+func FetchWorkOrdersREST(url string) ([]WorkOrder, error) {
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("fetching work orders from '%s': %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("work order endpoint '%s' returned status %d", url, resp.StatusCode)
+	}
+	var orders []WorkOrder
+	if err := json.NewDecoder(resp.Body).Decode(&orders); err != nil {
+		return nil, fmt.Errorf("decoding work order response: %w", err)
+	}
+	log.Printf("INFO: Fetched %d work order(s) from '%s'.", len(orders), url)
+	return orders, nil
+}
+
+// CreatePlaceholderJobs turns work orders into placeholder jobs with a
+// default sheet; the programmer fills in real dimensions and bends later.
+// Orders naming an unknown material fall back to the default material set.
+func CreatePlaceholderJobs(orders []WorkOrder) ([]*Job, error) {
+	mats := GetDefaultMaterials()
+	var jobs []*Job
+	for _, wo := range orders {
+		mat, ok := mats[MaterialName(wo.Material)]
+		if !ok {
+			mat = mats[SteelMaterial]
+			if wo.Material != "" {
+				log.Printf("WARNING: Work order '%s': unknown material '%s', using %s.", wo.OrderID, wo.Material, mat.Name)
+			}
+		}
+		sheet, err := NewSheetMetal(fmt.Sprintf("Sheet-%s", wo.PartNumber), 300.0, 100.0, 2.0, mat)
+		if err != nil {
+			return nil, fmt.Errorf("creating placeholder sheet for order '%s': %w", wo.OrderID, err)
+		}
+		job, err := NewJob(fmt.Sprintf("%s (%s x%d)", wo.PartNumber, wo.OrderID, wo.Quantity), sheet)
+		if err != nil {
+			return nil, fmt.Errorf("creating placeholder job for order '%s': %w", wo.OrderID, err)
+		}
+		job.OrderID = wo.OrderID
+		jobs = append(jobs, job)
+	}
+	log.Printf("INFO: Created %d placeholder job(s) from work orders.", len(jobs))
+	return jobs, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"log"
+	"sync"
+)
+
+// --- Internal event bus ---
+// Panels, exporters and background services all want to know when state
+// changes, and AppController should not have to call each of them by
+// name. Publishers post typed events to a single bus; subscribers register
+// a handler per event type. Handlers run synchronously on the publisher's
+// goroutine, so they must stay short and hand heavy work to their own
+// goroutine — the same rule the UI event loop already lives by.
+
+// EventType names a category of application event.
+type EventType string
+
+// Event types published by the core application.
+const (
+	EventJobChanged         EventType = "job-changed"         // Job structure or sheet edited.
+	EventToolingChanged     EventType = "tooling-changed"     // Punch or die selection changed.
+	EventSimulationFinished EventType = "simulation-finished" // A simulation run completed.
+)
+
+// Event is one occurrence posted to the bus.
+type Event struct {
+	Type EventType
+	Job  *Job // The job concerned, when one is; may be nil.
+}
+
+// EventHandler reacts to one event.
+type EventHandler func(Event)
+
+// EventBus routes published events to per-type subscriber lists.
+type EventBus struct {
+	mu       sync.RWMutex
+	nextID   int
+	handlers map[EventType]map[int]EventHandler
+}
+
+// eventBus is the application-wide bus.
+var eventBus = &EventBus{handlers: make(map[EventType]map[int]EventHandler)}
+
+// GetEventBus returns the application-wide bus.
+func GetEventBus() *EventBus { return eventBus }
+
+// Subscribe registers a handler for one event type and returns a function
+// that removes the subscription again.
+func (eb *EventBus) Subscribe(t EventType, h EventHandler) (unsubscribe func()) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	if eb.handlers[t] == nil {
+		eb.handlers[t] = make(map[int]EventHandler)
+	}
+	id := eb.nextID
+	eb.nextID++
+	eb.handlers[t][id] = h
+	return func() {
+		eb.mu.Lock()
+		delete(eb.handlers[t], id)
+		eb.mu.Unlock()
+	}
+}
+
+// Publish delivers an event to every subscriber of its type. A handler
+// that panics is logged and dropped from delivery of the rest.
+func (eb *EventBus) Publish(ev Event) {
+	eb.mu.RLock()
+	hs := make([]EventHandler, 0, len(eb.handlers[ev.Type]))
+	for _, h := range eb.handlers[ev.Type] {
+		hs = append(hs, h)
+	}
+	eb.mu.RUnlock()
+	for _, h := range hs {
+		eb.deliver(ev, h)
+	}
+}
+
+// deliver runs one handler, containing any panic so one bad subscriber
+// cannot take down the publisher.
+func (eb *EventBus) deliver(ev Event, h EventHandler) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("ERROR: Event handler for '%s' panicked: %v", ev.Type, r)
+		}
+	}()
+	h(ev)
+}
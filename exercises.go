@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+)
+
+// --- Training exercise authoring ---
+// Instructors define exercises: the target part (as a job), which tools the
+// student may use, and how the attempt is scored. Exercises are exported as
+// versioned packs that student installations import.
+
+// ScoringWeights sets the relative weight of each scoring criterion.
+// Weights are normalized at scoring time, so any positive scale works.
+type ScoringWeights struct {
+	SequenceEfficiency float64 `json:"sequence_efficiency"` // Fewer flips/regrips score higher.
+	CollisionsAvoided  float64 `json:"collisions_avoided"`  // No collision warnings.
+	Time               float64 `json:"time"`                // Faster completion scores higher.
+}
+
+// Exercise is one authored training task.
+type Exercise struct {
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	Target         *Job           `json:"target"`          // The part the student must program.
+	AllowedPunches []string       `json:"allowed_punches"` // Empty = all punches allowed.
+	AllowedDies    []string       `json:"allowed_dies"`    // Empty = all dies allowed.
+	Weights        ScoringWeights `json:"weights"`
+	TimeLimitSec   float64        `json:"time_limit_sec,omitempty"` // 0 = no limit.
+}
+
+// ExercisePack is a set of exercises exported for distribution.
+type ExercisePack struct {
+	Title     string     `json:"title"`
+	Author    string     `json:"author"`
+	Exercises []Exercise `json:"exercises"`
+}
+
+// SchemaExercise versions exercise packs alongside the other data files.
+const SchemaExercise SchemaKind = "exercise"
+
+func init() {
+	currentSchemaVersions[SchemaExercise] = 1
+}
+
+// NewExercise creates an exercise for a target job, validating the basics.
+func NewExercise(name, description string, target *Job, weights ScoringWeights) (*Exercise, error) {
+	if err := RequireConfigUnlocked("exercise authoring"); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, fmt.Errorf("exercise name cannot be empty")
+	}
+	if target == nil || target.Sheet == nil || len(target.Steps) == 0 {
+		return nil, fmt.Errorf("exercise target must be a job with a sheet and at least one bend")
+	}
+	return &Exercise{Name: name, Description: description, Target: target, Weights: weights}, nil
+}
+
+// ExportExercisePack writes a pack to disk in the versioned envelope format.
+func ExportExercisePack(pack *ExercisePack, filePath string) error {
+	if err := RequireConfigUnlocked("exercise authoring"); err != nil {
+		return err
+	}
+	if pack == nil || len(pack.Exercises) == 0 {
+		return fmt.Errorf("exercise pack is empty")
+	}
+	doc, err := WrapDocument(SchemaExercise, pack)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling exercise pack: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("writing exercise pack '%s': %w", filePath, err)
+	}
+	log.Printf("INFO: Exported exercise pack '%s' (%d exercises) to '%s'.", pack.Title, len(pack.Exercises), filePath)
+	return nil
+}
+
+// ImportExercisePack loads a pack, migrating older versions as needed.
+func ImportExercisePack(filePath string) (*ExercisePack, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading exercise pack: %w", err)
+	}
+	doc := &VersionedDocument{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("decoding exercise pack '%s': %w", filePath, err)
+	}
+	if doc.Schema != SchemaExercise {
+		return nil, fmt.Errorf("'%s' is a %s file, not an exercise pack", filePath, doc.Schema)
+	}
+	payload, err := MigrateToCurrent(doc)
+	if err != nil {
+		return nil, err
+	}
+	pack := &ExercisePack{}
+	if err := json.Unmarshal(payload, pack); err != nil {
+		return nil, fmt.Errorf("decoding exercise pack payload: %w", err)
+	}
+	log.Printf("INFO: Imported exercise pack '%s' (%d exercises) from '%s'.", pack.Title, len(pack.Exercises), filePath)
+	return pack, nil
+}
+
+// ToolAllowed reports whether a tool name is permitted by the exercise.
+func (ex *Exercise) ToolAllowed(names []string, tool string) bool {
+	if len(names) == 0 {
+		return true
+	}
+	for _, n := range names {
+		if n == tool {
+			return true
+		}
+	}
+	return false
+}
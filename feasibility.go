@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Machine fit feasibility (throat and open height) ---
+// A sequence can be geometrically valid and still impossible on the machine:
+// a deep box's walls collide with the ram/frame, or a tall rear flange does
+// not fit into the throat. The machine profile now carries the relevant
+// frame geometry and each step is checked against it.
+
+// CheckThroatFeasibility walks the bend sequence and reports, per step, when
+// already-formed flanges will not fit between ram and frame. The model is a
+// conservative 2D one: after each bend the up-turned portions ahead of and
+// behind the bend line are compared against the machine's open height and
+// throat depth.
+func CheckThroatFeasibility(j *Job, pb *PressBrake) ([]string, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for feasibility check")
+	}
+	if pb == nil {
+		return nil, fmt.Errorf("press brake is nil for feasibility check")
+	}
+	if pb.OpenHeight <= 0 && pb.ThroatDepth <= 0 {
+		return nil, nil // No frame geometry configured; nothing to check.
+	}
+
+	var warnings []string
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		rot := (180.0 - step.TargetAngle) * math.Pi / 180.0
+		frontRise := step.Position * math.Sin(rot)
+		rearLen := j.Sheet.OriginalLength - step.Position
+		rearRise := rearLen * math.Sin(rot)
+
+		if daylight := pb.EffectiveOpenHeight(); daylight > 0 && step.Direction == BendDirectionUp {
+			// An up bend lifts the flange toward the ram: it must clear the
+			// daylight left after holders/risers under the die.
+			if frontRise > daylight || rearRise > daylight {
+				warnings = append(warnings, fmt.Sprintf(
+					"step %d: flange rises %.0fmm, exceeding available open height (%.0fmm with die stack) - part will not fit under the ram",
+					i+1, math.Max(frontRise, rearRise), daylight))
+				continue
+			}
+		}
+		if pb.ThroatDepth > 0 && rearLen > pb.ThroatDepth && step.Direction == BendDirectionDown {
+			// A down bend pushes the rear portion back into the frame gap.
+			warnings = append(warnings, fmt.Sprintf(
+				"step %d: rear section %.0fmm deep exceeds machine throat depth (%.0fmm)",
+				i+1, rearLen, pb.ThroatDepth))
+		}
+	}
+	return warnings, nil
+}
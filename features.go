@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+)
+
+// --- Feature flags ---
+// Some deployments want a leaner footprint than others: a training room
+// has no use for the REST server, a quoting desk rarely needs Monte Carlo
+// studies. Features are switched on or off per install through a plain
+// local JSON file; nothing phones home and there is no license server —
+// the file IS the configuration. Unknown and unlisted features default
+// to enabled so an old config never hides a new module.
+
+// Feature names recognized by the flag registry.
+const (
+	Feature3D         = "3d-visualization"
+	FeatureMonteCarlo = "monte-carlo"
+	FeatureRESTServer = "rest-server"
+)
+
+// featureConfigFile is the per-install flag file, read at startup.
+const featureConfigFile = "features.json"
+
+// FeatureSet holds the enabled/disabled state of optional modules.
+type FeatureSet struct {
+	mu    sync.RWMutex
+	flags map[string]bool // Only overrides are stored; absent means enabled.
+}
+
+// featureSet is the application-wide flag registry.
+var featureSet = &FeatureSet{flags: make(map[string]bool)}
+
+// GetFeatureSet returns the application-wide flag registry.
+func GetFeatureSet() *FeatureSet { return featureSet }
+
+// Enabled reports whether the named feature is active in this install.
+func (fs *FeatureSet) Enabled(name string) bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	enabled, ok := fs.flags[name]
+	if !ok {
+		return true
+	}
+	return enabled
+}
+
+// SetEnabled overrides a feature's state for this install.
+func (fs *FeatureSet) SetEnabled(name string, enabled bool) {
+	fs.mu.Lock()
+	fs.flags[name] = enabled
+	fs.mu.Unlock()
+	log.Printf("INFO: Feature '%s' set to enabled=%v.", name, enabled)
+}
+
+// Overrides returns the configured overrides, sorted by feature name.
+func (fs *FeatureSet) Overrides() map[string]bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	out := make(map[string]bool, len(fs.flags))
+	for k, v := range fs.flags {
+		out[k] = v
+	}
+	return out
+}
+
+// RequireFeature returns an error suitable for the status bar when the
+// named feature is disabled in this install.
+func RequireFeature(name string) error {
+	if featureSet.Enabled(name) {
+		return nil
+	}
+	return fmt.Errorf("feature '%s' is disabled in this install (see %s)", name, featureConfigFile)
+}
+
+// LoadFeatureConfig reads the flag file if present. A missing file is not
+// an error: every feature stays enabled.
+func LoadFeatureConfig(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		log.Printf("INFO: No feature config at '%s'; all features enabled.", filePath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading feature config: %w", err)
+	}
+	flags := make(map[string]bool)
+	if err := json.Unmarshal(data, &flags); err != nil {
+		return fmt.Errorf("parsing feature config '%s': %w", filePath, err)
+	}
+	featureSet.mu.Lock()
+	featureSet.flags = flags
+	featureSet.mu.Unlock()
+
+	names := make([]string, 0, len(flags))
+	for name, enabled := range flags {
+		if !enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	log.Printf("INFO: Loaded feature config '%s'; %d override(s), disabled: %v.", filePath, len(flags), names)
+	return nil
+}
+
+// SaveFeatureConfig writes the current overrides back to the flag file.
+func SaveFeatureConfig(filePath string) error {
+	data, err := json.MarshalIndent(featureSet.Overrides(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing feature config: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("writing feature config '%s': %w", filePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Bend positions from flange lengths ---
+// Drawings dimension finished flanges, not flat bend-line positions, so
+// programming from a print means doing deduction arithmetic by hand. The
+// solver takes the flange chain as drawn and produces the flat positions,
+// splitting each bend deduction between its two adjacent flanges the way
+// the allowance model distributes it.
+
+// FlangeSpec is one flange and the bend that follows it. The last flange
+// in a chain carries no bend and its angle fields are ignored.
+type FlangeSpec struct {
+	LengthMM  float64       // Finished outside flange length from the drawing.
+	AngleDeg  float64       // Internal angle of the bend after this flange.
+	RadiusMM  float64       // Inner radius of that bend.
+	Direction BendDirection // Direction of that bend.
+}
+
+// FlangeSolution is the solver output: the required blank length and the
+// bend steps positioned on the flat.
+type FlangeSolution struct {
+	BlankLengthMM float64
+	Steps         []*BendStep
+}
+
+// SolveBendPositions converts a chain of n flanges (n-1 bends) into flat
+// bend positions for the given sheet thickness. Positions come out
+// measured from the reference edge at the first flange.
+func SolveBendPositions(flanges []FlangeSpec, thicknessMM float64) (*FlangeSolution, error) {
+	if len(flanges) < 2 {
+		return nil, fmt.Errorf("at least two flanges are required to place a bend, got %d", len(flanges))
+	}
+	if thicknessMM <= 0 {
+		return nil, fmt.Errorf("thickness must be positive, got %.2f", thicknessMM)
+	}
+
+	sol := &FlangeSolution{}
+	flatCursor := 0.0 // Consumed flat length up to the current bend line.
+	for i, fl := range flanges {
+		if fl.LengthMM <= 0 {
+			return nil, fmt.Errorf("flange %d has non-positive length %.2fmm", i+1, fl.LengthMM)
+		}
+		last := i == len(flanges)-1
+		if last {
+			sol.BlankLengthMM = flatCursor + fl.LengthMM
+			break
+		}
+		if fl.AngleDeg < minBendAngle || fl.AngleDeg > maxBendAngle {
+			return nil, fmt.Errorf("bend %d angle %.1f° is outside %.1f-%.1f°", i+1, fl.AngleDeg, minBendAngle, maxBendAngle)
+		}
+		_, deduction := allowanceForAngle(fl.AngleDeg, fl.RadiusMM, thicknessMM)
+		// The drawing measures each flange to the bend apex; half the
+		// deduction comes off each side of the bend line on the flat.
+		flatCursor += fl.LengthMM - deduction/2
+		sol.Steps = append(sol.Steps, &BendStep{
+			SequenceOrder: i + 1,
+			Position:      flatCursor,
+			TargetAngle:   fl.AngleDeg,
+			Radius:        fl.RadiusMM,
+			Direction:     fl.Direction,
+		})
+		flatCursor -= deduction / 2
+	}
+
+	// Sanity: positions must stay inside the blank and in ascending order.
+	prev := 0.0
+	for _, step := range sol.Steps {
+		if step.Position <= prev || step.Position >= sol.BlankLengthMM {
+			return nil, fmt.Errorf("solved position %.2fmm for bend %d falls outside the %.2fmm blank; check flange lengths vs deductions",
+				step.Position, step.SequenceOrder, sol.BlankLengthMM)
+		}
+		prev = step.Position
+	}
+	return sol, nil
+}
+
+// ApplyFlangeSolution replaces the job's steps with the solved sequence
+// and checks the blank length against the sheet, within tolMM.
+func (jc *JobController) ApplyFlangeSolution(sol *FlangeSolution, tolMM float64) error {
+	if jc.currentJob == nil || jc.currentJob.Sheet == nil {
+		return fmt.Errorf("no active job to apply flange solution to")
+	}
+	if sol == nil {
+		return fmt.Errorf("flange solution is nil")
+	}
+	if diff := math.Abs(sol.BlankLengthMM - jc.currentJob.Sheet.OriginalLength); diff > tolMM {
+		return fmt.Errorf("solved blank length %.2fmm differs from sheet length %.2fmm by %.2fmm (tolerance %.2fmm); recut the blank or adjust flanges",
+			sol.BlankLengthMM, jc.currentJob.Sheet.OriginalLength, diff, tolMM)
+	}
+	jc.currentJob.Steps = sol.Steps
+	jc.valCache.reset()
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// --- Developed-length calculation engine ---
+// ProcessJob records bends on the sheet but never did the flat-pattern
+// math; the pieces lived scattered in the allowance chart and the
+// simulation summary. This module is the one place that turns a bend
+// sequence into numbers: per-bend K-factor, allowance and deduction, the
+// formed flange lengths, and the relationship between blank length and
+// formed outside dimensions. The UI, the SVG generator and the exporters
+// consume this result instead of re-deriving fragments of it.
+
+// DevelopedBend is the flat-pattern contribution of one bend step.
+type DevelopedBend struct {
+	SequenceOrder int
+	Position      float64 // Bend line on the flat blank (mm).
+	EffRadius     float64 // Achievable inner radius used for the math (mm).
+	KFactor       float64 // Neutral-axis factor applied.
+	Allowance     float64 // Neutral-axis arc length (mm).
+	Deduction     float64 // Flat-length deduction (mm).
+}
+
+// DevelopedLength is the full flat-pattern result for a job.
+type DevelopedLength struct {
+	Bends          []DevelopedBend
+	FlangeLengths  []float64 // Formed outside flange lengths, left to right (mm).
+	FlatLengthMM   float64   // Blank length (the sheet as programmed).
+	OutsideSumMM   float64   // Sum of formed outside dimensions.
+	TotalDeduction float64   // OutsideSumMM - FlatLengthMM.
+}
+
+// ComputeDevelopedLength runs the K-factor engine over the job's enabled
+// steps. Positions are taken on the flat blank, as entered.
+func ComputeDevelopedLength(j *Job) (*DevelopedLength, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for developed-length calculation")
+	}
+	t := j.Sheet.Thickness
+
+	var steps []*BendStep
+	for _, step := range j.Steps {
+		if step != nil && !step.Disabled {
+			steps = append(steps, step)
+		}
+	}
+	sort.Slice(steps, func(a, b int) bool { return steps[a].Position < steps[b].Position })
+
+	res := &DevelopedLength{FlatLengthMM: j.Sheet.OriginalLength}
+	for _, step := range steps {
+		eff := EffectiveInnerRadius(step.Radius, t)
+		// The table is preferred when the shop has measured this condition;
+		// V opening is unknown at this level, so thickness/angle match it.
+		ba, bd := allowanceWithTable(j.Sheet.Material.Name, t, 0, step.TargetAngle, step.Radius)
+		res.Bends = append(res.Bends, DevelopedBend{
+			SequenceOrder: step.SequenceOrder,
+			Position:      step.Position,
+			EffRadius:     eff,
+			KFactor:       estimateKFactor(eff, t),
+			Allowance:     ba,
+			Deduction:     bd,
+		})
+		res.TotalDeduction += bd
+	}
+
+	// Formed outside flange lengths: each flat segment between adjacent
+	// bend lines gains half the deduction of each bend bounding it.
+	prev := 0.0
+	for i, db := range res.Bends {
+		length := db.Position - prev + db.Deduction/2
+		if i > 0 {
+			length += res.Bends[i-1].Deduction / 2
+		}
+		res.FlangeLengths = append(res.FlangeLengths, length)
+		prev = db.Position
+	}
+	last := res.FlatLengthMM - prev
+	if n := len(res.Bends); n > 0 {
+		last += res.Bends[n-1].Deduction / 2
+	}
+	res.FlangeLengths = append(res.FlangeLengths, last)
+
+	for _, fl := range res.FlangeLengths {
+		res.OutsideSumMM += fl
+	}
+	return res, nil
+}
+
+// FlatLengthForFlanges is the inverse problem: given desired formed
+// outside dimensions and the bends between them, return the blank length.
+func FlatLengthForFlanges(outsideMM []float64, bends []DevelopedBend) (float64, error) {
+	if len(outsideMM) != len(bends)+1 {
+		return 0, fmt.Errorf("need one more flange than bends (got %d flanges, %d bends)", len(outsideMM), len(bends))
+	}
+	total := 0.0
+	for _, fl := range outsideMM {
+		total += fl
+	}
+	for _, db := range bends {
+		total -= db.Deduction
+	}
+	return total, nil
+}
+
+// FormatDevelopedLength renders the engine result for the UI and reports.
+func FormatDevelopedLength(res *DevelopedLength) string {
+	if res == nil {
+		return "No developed-length result."
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Flat (blank) length: %smm\n", FormatExportPosition(res.FlatLengthMM)))
+	sb.WriteString(fmt.Sprintf("Formed outside sum:  %smm (total deduction %smm)\n",
+		FormatExportPosition(res.OutsideSumMM), FormatExportPosition(res.TotalDeduction)))
+	for _, db := range res.Bends {
+		sb.WriteString(fmt.Sprintf("  Bend %d @ %smm: K=%.2f  BA=%smm  BD=%smm\n",
+			db.SequenceOrder, FormatExportPosition(db.Position), db.KFactor,
+			FormatExportPosition(db.Allowance), FormatExportPosition(db.Deduction)))
+	}
+	sb.WriteString("Flanges: ")
+	for i, fl := range res.FlangeLengths {
+		if i > 0 {
+			sb.WriteString(" | ")
+		}
+		sb.WriteString(FormatExportPosition(fl))
+	}
+	sb.WriteString(" mm\n")
+	return sb.String()
+}
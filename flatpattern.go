@@ -0,0 +1,40 @@
+package main
+
+import "fmt"
+
+// --- Flat pattern (blank length) ---
+// "What do I cut?" is the first question at the shear. The developed-
+// length engine knows; this is the job-level convenience over it: one
+// number for the blank, shown live next to the sheet properties in the
+// UI together with the formed outside dimensions it develops into.
+
+// FlatPattern returns the unfolded blank length in mm. The developed-
+// length engine treats the programmed sheet length as the flat blank
+// (positions are entered on it), so the blank to cut is FlatLengthMM as
+// is; the per-bend deductions relate it to the formed outside sum, they
+// are not subtracted from it again.
+func (j *Job) FlatPattern() (float64, error) {
+	dev, err := ComputeDevelopedLength(j)
+	if err != nil {
+		return 0, err
+	}
+	return dev.FlatLengthMM, nil
+}
+
+// flatPatternLabel renders the blank size line for the sheet panel.
+func (ac *AppController) flatPatternLabel() string {
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil {
+		return ""
+	}
+	dev, err := ComputeDevelopedLength(ac.currentJob)
+	if err != nil {
+		return ""
+	}
+	if len(dev.Bends) == 0 {
+		return fmt.Sprintf("Blank to cut: %smm x %smm (no bends)",
+			FormatExportPosition(dev.FlatLengthMM), FormatExportPosition(ac.currentJob.Sheet.Width))
+	}
+	return fmt.Sprintf("Blank to cut: %smm x %smm (forms %smm outside, %smm deducted)",
+		FormatExportPosition(dev.FlatLengthMM), FormatExportPosition(ac.currentJob.Sheet.Width),
+		FormatExportPosition(dev.OutsideSumMM), FormatExportPosition(dev.TotalDeduction))
+}
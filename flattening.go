@@ -0,0 +1,56 @@
+package main
+
+// --- Near-180° flattening ---
+// Flattening a hem means driving the fold past any conventional bend
+// angle: the target is a true 180°. The general angle model capped at
+// 179° because the setback formula (tan of half the swept angle) blows
+// up as the fold closes, and the air-bend force formula has no meaning
+// once the punch is pressing a closed fold flat against the die. Angles
+// in the flattening band get their own developed-length and force rules
+// so hem sequences can state what they actually do.
+
+const (
+	// flatteningAngleThresholdDeg: targets above this are flattening hits,
+	// not conventional bends.
+	flatteningAngleThresholdDeg = 175.0
+	// maxFlatteningAngleDeg is the fully closed fold.
+	maxFlatteningAngleDeg = 180.0
+	// hemAllowanceFactor: developed length consumed by a closed hem, as a
+	// fraction of thickness (the common 1.5t rule of thumb).
+	hemAllowanceFactor = 1.5
+	// flatteningForceFactor relates flattening force to UTS and thickness:
+	// F [kN/m] = factor * UTS [MPa] * t [mm]. Pressing a fold closed is a
+	// die-contact operation; the die V opening plays no part.
+	flatteningForceFactor = 0.4
+)
+
+// isFlatteningAngle reports whether a target angle is in the flattening
+// band handled by the closed-fold models.
+func isFlatteningAngle(angleDeg float64) bool {
+	return angleDeg > flatteningAngleThresholdDeg
+}
+
+// IsFlatteningStep reports whether a step is a flattening hit, either by
+// its assigned station or by its target angle.
+func (bs *BendStep) IsFlatteningStep() bool {
+	return bs != nil && (bs.Station == StationFlattening || isFlatteningAngle(bs.TargetAngle))
+}
+
+// flatteningAllowance returns the allowance/deduction pair for a closed
+// fold. A hem consumes roughly 1.5t of developed length; because the two
+// legs of the fold lie on top of each other, the "outside dimensions"
+// convention makes the deduction the negative of the allowance.
+func flatteningAllowance(thickness float64) (allowance, deduction float64) {
+	allowance = hemAllowanceFactor * thickness
+	return allowance, -allowance
+}
+
+// FlatteningForcePerMeter returns the force to press a fold flat, in kN
+// per meter of hem length.
+func FlatteningForcePerMeter(sheet *SheetMetal) float64 {
+	if sheet == nil {
+		return 0
+	}
+	uts := tensileFromYield(EffectiveYieldStress(sheet.Material))
+	return flatteningForceFactor * uts * sheet.Thickness
+}
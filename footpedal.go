@@ -0,0 +1,103 @@
+package main
+
+import (
+	"log"
+	"sync"
+)
+
+// --- Foot pedal simulation ---
+// Correct pedal discipline is a core training goal: the ram only moves
+// while the pedal is held, releasing stops it dead where it is, and the
+// ram switches from fast approach to creep speed at the mute point (where
+// the light curtain is muted and fingers can no longer clear the tools).
+
+// FootPedal models the pedal driving the stroke animation.
+type FootPedal struct {
+	mu           sync.Mutex
+	anim         *StrokeAnimation
+	pressed      bool
+	MutePointPct float64 // Stroke fraction where fast approach ends (e.g. 0.7).
+	FastSpeed    float64 // Approach speed, stroke fraction per second.
+	CreepSpeed   float64 // Working speed below the mute point.
+	ReturnSpeed  float64 // Ram return speed when opening.
+	opening      bool    // Ram is returning to top after a completed stroke.
+}
+
+// NewFootPedal returns a pedal with typical machine speeds attached to the
+// given animation state.
+func NewFootPedal(anim *StrokeAnimation) *FootPedal {
+	return &FootPedal{
+		anim:         anim,
+		MutePointPct: 0.7,
+		FastSpeed:    0.8,
+		CreepSpeed:   0.12,
+		ReturnSpeed:  1.0,
+	}
+}
+
+// Press starts or resumes the closing motion (press-and-hold).
+func (fp *FootPedal) Press() {
+	fp.mu.Lock()
+	fp.pressed = true
+	fp.opening = false
+	fp.mu.Unlock()
+}
+
+// Release stops the ram immediately, wherever it is in the stroke. This is
+// the safety behavior trainees must learn: nothing moves without the pedal.
+func (fp *FootPedal) Release() {
+	fp.mu.Lock()
+	if fp.pressed {
+		fp.pressed = false
+		if pct, _, _ := fp.anim.Snapshot(); pct > 0 && pct < 1 {
+			log.Printf("INFO: Pedal released mid-stroke: ram held at %.0f%%.", pct*100)
+		}
+	}
+	fp.mu.Unlock()
+}
+
+// Open commands the ram back to the top (after a completed bend).
+func (fp *FootPedal) Open() {
+	fp.mu.Lock()
+	fp.opening = true
+	fp.pressed = false
+	fp.mu.Unlock()
+}
+
+// Tick advances the simulated ram by dt seconds and reports whether the
+// position changed. Call it from the animation frame loop.
+func (fp *FootPedal) Tick(dtSec float64) bool {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+	if fp.anim == nil || dtSec <= 0 {
+		return false
+	}
+
+	fp.anim.mu.Lock()
+	defer fp.anim.mu.Unlock()
+	before := fp.anim.RamPct
+
+	switch {
+	case fp.opening:
+		fp.anim.RamPct -= fp.ReturnSpeed * dtSec
+		if fp.anim.RamPct <= 0 {
+			fp.anim.RamPct = 0
+			fp.opening = false
+		}
+	case fp.pressed:
+		speed := fp.FastSpeed
+		if fp.anim.RamPct >= fp.MutePointPct {
+			// Below the mute point the ram creeps: two-stage speed change.
+			speed = fp.CreepSpeed
+		}
+		fp.anim.RamPct += speed * dtSec
+		if fp.anim.RamPct >= 1 {
+			fp.anim.RamPct = 1
+			fp.pressed = false // Bottom dead center: stroke complete.
+			log.Println("INFO: Stroke complete (bottom dead center reached).")
+		}
+	default:
+		return false // Pedal up and not opening: ram holds position.
+	}
+	return fp.anim.RamPct != before
+}
@@ -0,0 +1,44 @@
+package main
+
+// --- Friction and coating effects ---
+// Sheet coatings (galvanizing, oil) and die surface finish change how the
+// sheet slides over the die shoulders during the bend. The effect on force
+// is small but measurable — a dry stainless sheet on worn shoulders draws
+// noticeably harder than an oiled mild-steel sheet on polished dies — and
+// the same sliding friction is what scores cosmetic surfaces. Both factors
+// default to 1.0 so existing library entries behave exactly as before.
+
+// DieFinish describes the die shoulder surface condition.
+type DieFinish string
+
+const (
+	FinishStandard DieFinish = "Standard" // Ground tool steel, the default.
+	FinishPolished DieFinish = "Polished" // Polished or chromed shoulders.
+	FinishWorn     DieFinish = "Worn"     // Scored/galled shoulders.
+)
+
+// frictionForFinish returns the force multiplier contributed by the die
+// shoulder finish. An unset finish counts as standard.
+func frictionForFinish(f DieFinish) float64 {
+	switch f {
+	case FinishPolished:
+		return 0.95
+	case FinishWorn:
+		return 1.10
+	default:
+		return 1.0
+	}
+}
+
+// FrictionFactorFor combines the material's own friction factor with the
+// die finish into one multiplier for force and marking estimates.
+func FrictionFactorFor(mat MaterialDetails, die *Die) float64 {
+	factor := mat.FrictionFactor
+	if factor <= 0 {
+		factor = 1.0
+	}
+	if die != nil {
+		factor *= frictionForFinish(die.Finish)
+	}
+	return factor
+}
@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+// --- Back gauge position and per-step offsets ---
+// When a flange needs a deliberate extra gap (paint build-up, weld root
+// opening), operators used to fudge the bend position and nobody could
+// later tell a corrected program from a wrong one. The offset is now its
+// own field: the programmed position stays the drawing value, the gauge
+// calculation applies the offset, and paperwork shows both.
+
+// GaugePositionForStep returns the back gauge position for a step: the
+// programmed bend position plus the step's declared allowance offset.
+func GaugePositionForStep(step *BendStep) float64 {
+	if step == nil {
+		return 0
+	}
+	return step.Position + step.AllowanceOffsetMM
+}
+
+// describeStepOffset formats the offset annotation for lists and exports,
+// or "" when the step has none.
+func describeStepOffset(step *BendStep) string {
+	if step == nil || step.AllowanceOffsetMM == 0 {
+		return ""
+	}
+	return fmt.Sprintf("  [gauge %+.2fmm: %s]", step.AllowanceOffsetMM, offsetReasonOrDefault(step))
+}
+
+func offsetReasonOrDefault(step *BendStep) string {
+	if step.OffsetReason != "" {
+		return step.OffsetReason
+	}
+	return "compensation"
+}
@@ -0,0 +1,53 @@
+package main
+
+// --- Gauge retract and pinch-point options ---
+// Two stock controller options the model lacked: retracting the back
+// gauge mid-stroke so an up-swinging flange cannot land on the fingers,
+// and pausing the ram at the pinch point until the operator confirms the
+// sheet sits right. Both are per-step flags on BendStep; the program
+// stream, the cycle estimate and the stroke animation all honor them.
+
+const (
+	// pinchPointPct is the ram position (fraction of the stroke) where the
+	// punch first grips the sheet and a confirmation hold takes place.
+	pinchPointPct = 0.25
+	// gaugeRetractPct is the ram position where a retracting gauge starts
+	// to move clear.
+	gaugeRetractPct = 0.5
+)
+
+// stepOptionTokens renders a step's controller options for the program
+// stream ("" when none are set).
+func stepOptionTokens(step *BendStep) string {
+	if step == nil {
+		return ""
+	}
+	tokens := ""
+	if step.GaugeRetract {
+		tokens += " RETRACT"
+	}
+	if step.PinchConfirm {
+		tokens += " PINCH"
+	}
+	return tokens
+}
+
+// ArmPinchHold prepares the animation for a step: a confirming step holds
+// the ram at the pinch point until PendantConfirm releases it.
+func (sa *StrokeAnimation) ArmPinchHold(step *BendStep) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	if step != nil && step.PinchConfirm {
+		sa.PinchHoldPct = pinchPointPct
+	} else {
+		sa.PinchHoldPct = 0
+	}
+	sa.PinchReleased = false
+}
+
+// HeldAtPinch reports whether the ram is waiting for pinch confirmation.
+func (sa *StrokeAnimation) HeldAtPinch() bool {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	return sa.PinchHoldPct > 0 && !sa.PinchReleased && sa.RamPct >= sa.PinchHoldPct
+}
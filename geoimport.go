@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// --- Trumpf GEO import ---
+// TruTops-prepared flat parts travel as .GEO files: block-structured
+// text with '#~<n>' section markers, point definitions and contour /
+// bend-line elements, each block closed by '|~'. This importer reads the
+// subset bending cares about — the sheet header (#~1: name, thickness),
+// the point table (#~31) and bend lines with their angle/radius
+// attributes (#~37) — and maps them onto a Job so a TruTops part can be
+// simulated without retyping it. Unknown sections are skipped, so richer
+// files from newer TruTops versions still import.
+
+// geoBendLine is one parsed bend line before mapping.
+type geoBendLine struct {
+	X        float64 // Bend line position on the blank (mm).
+	AngleDeg float64 // Signed swept angle; negative bends down.
+	RadiusMM float64
+}
+
+// geoPoint is one entry of the point table.
+type geoPoint struct{ X, Y float64 }
+
+// ImportGEOJob reads a .GEO flat part and builds a job: blank size from
+// the contour extent, thickness from the header, one bend step per bend
+// line. Bend lines are assumed parallel to the blank's Y axis (the common
+// TruTops export orientation); skewed bend lines are rejected per line
+// with a warning rather than failing the import.
+func ImportGEOJob(path string) (*Job, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening GEO file '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	partName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	thickness := 0.0
+	materialName := ""
+	points := make(map[string]geoPoint)
+	var bends []geoBendLine
+
+	section := ""
+	var block []string
+	flushBlock := func() {
+		if len(block) == 0 {
+			return
+		}
+		switch section {
+		case "1": // Header: key-value-ish lines.
+			for i, line := range block {
+				switch strings.TrimSpace(line) {
+				case "NAME":
+					if i+1 < len(block) {
+						partName = strings.TrimSpace(block[i+1])
+					}
+				case "SHEET_THICKNESS", "DICKE":
+					if i+1 < len(block) {
+						if v, convErr := ParseUserFloat(strings.TrimSpace(block[i+1])); convErr == nil {
+							thickness = v
+						}
+					}
+				case "MATERIAL":
+					if i+1 < len(block) {
+						materialName = strings.TrimSpace(block[i+1])
+					}
+				}
+			}
+		case "31": // Point table: "P" / "<id>" / "<x> <y> [z]".
+			if len(block) >= 3 && strings.TrimSpace(block[0]) == "P" {
+				id := strings.TrimSpace(block[1])
+				coords := strings.Fields(block[2])
+				if len(coords) >= 2 {
+					x, errX := ParseUserFloat(coords[0])
+					y, errY := ParseUserFloat(coords[1])
+					if errX == nil && errY == nil {
+						points[id] = geoPoint{X: x, Y: y}
+					}
+				}
+			}
+		case "37": // Bend line: "BL" / "<p1> <p2>" / "<angle> <radius>".
+			if len(block) >= 3 && strings.TrimSpace(block[0]) == "BL" {
+				refs := strings.Fields(block[1])
+				attrs := strings.Fields(block[2])
+				if len(refs) >= 2 && len(attrs) >= 2 {
+					p1, ok1 := points[refs[0]]
+					p2, ok2 := points[refs[1]]
+					angle, errA := ParseUserFloat(attrs[0])
+					radius, errR := ParseUserFloat(attrs[1])
+					if ok1 && ok2 && errA == nil && errR == nil {
+						if math.Abs(p1.X-p2.X) > 0.01 {
+							log.Printf("WARNING: GEO '%s': skipping skewed bend line (%.1f,%.1f)-(%.1f,%.1f); only Y-parallel bends import.",
+								partName, p1.X, p1.Y, p2.X, p2.Y)
+						} else {
+							bends = append(bends, geoBendLine{X: p1.X, AngleDeg: angle, RadiusMM: radius})
+						}
+					}
+				}
+			}
+		}
+		block = block[:0]
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "#~"):
+			flushBlock()
+			section = strings.TrimPrefix(trimmed, "#~")
+		case trimmed == "|~" || trimmed == "##~~":
+			flushBlock()
+		default:
+			block = append(block, line)
+		}
+	}
+	flushBlock()
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading GEO file '%s': %w", path, err)
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("GEO file '%s' has no point table", path)
+	}
+
+	// Blank extent from the point table.
+	minX, maxX := math.MaxFloat64, -math.MaxFloat64
+	minY, maxY := math.MaxFloat64, -math.MaxFloat64
+	for _, p := range points {
+		minX = math.Min(minX, p.X)
+		maxX = math.Max(maxX, p.X)
+		minY = math.Min(minY, p.Y)
+		maxY = math.Max(maxY, p.Y)
+	}
+	length := maxX - minX
+	width := maxY - minY
+	if length <= 0 || width <= 0 {
+		return nil, fmt.Errorf("GEO file '%s' has a degenerate contour (%.1f x %.1f)", path, length, width)
+	}
+	if thickness <= 0 {
+		thickness = 1.0
+		log.Printf("WARNING: GEO '%s': no sheet thickness in header, defaulting to %.1fmm.", partName, thickness)
+	}
+
+	mats := GetDefaultMaterials()
+	mat, ok := mats[MaterialName(materialName)]
+	if !ok {
+		mat = mats[SteelMaterial]
+		if materialName != "" {
+			log.Printf("WARNING: GEO '%s': unknown material '%s', using %s.", partName, materialName, mat.Name)
+		}
+	}
+
+	sheet, err := NewSheetMetal(fmt.Sprintf("Sheet-%s", partName), length, width, thickness, mat)
+	if err != nil {
+		return nil, fmt.Errorf("creating sheet from GEO '%s': %w", path, err)
+	}
+	job, err := NewJob(partName, sheet)
+	if err != nil {
+		return nil, fmt.Errorf("creating job from GEO '%s': %w", path, err)
+	}
+
+	sort.Slice(bends, func(a, b int) bool { return bends[a].X < bends[b].X })
+	for i, bl := range bends {
+		dir := BendDirectionUp
+		swept := bl.AngleDeg
+		if swept < 0 { // TruTops signs down bends negative.
+			dir = BendDirectionDown
+			swept = -swept
+		}
+		internal := 180.0 - swept
+		if internal < minBendAngle {
+			internal = minBendAngle
+		}
+		step := &BendStep{
+			SequenceOrder: i + 1,
+			Position:      bl.X - minX,
+			TargetAngle:   internal,
+			Radius:        bl.RadiusMM,
+			Direction:     dir,
+		}
+		job.Steps = append(job.Steps, step)
+	}
+
+	log.Printf("INFO: Imported GEO '%s': %.0fx%.0fx%.1fmm %s, %d bend line(s).",
+		partName, length, width, thickness, mat.Name, len(job.Steps))
+	return job, nil
+}
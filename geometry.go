@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Shared-geometry folded states ---
+// Scrubbing through a long job previews the sheet after each step. Storing a
+// deep copy of the geometry per step is wasteful (100 steps x many segments);
+// instead the flat segment data is computed once and shared, and each folded
+// state only stores one rigid transform per segment. Points are materialized
+// on demand when a state is actually drawn.
+
+// Point2 is a point in the sheet cross-section plane (mm).
+type Point2 struct {
+	X, Y float64
+}
+
+// Transform2 is a 2D rigid transform (rotation plus translation).
+type Transform2 struct {
+	Cos, Sin float64 // Rotation.
+	TX, TY   float64 // Translation (mm).
+}
+
+// IdentityTransform returns the transform that leaves points unchanged.
+func IdentityTransform() Transform2 {
+	return Transform2{Cos: 1}
+}
+
+// RotationAbout returns the transform rotating by angle radians about pivot.
+func RotationAbout(pivot Point2, angle float64) Transform2 {
+	c, s := math.Cos(angle), math.Sin(angle)
+	return Transform2{
+		Cos: c, Sin: s,
+		TX: pivot.X - c*pivot.X + s*pivot.Y,
+		TY: pivot.Y - s*pivot.X - c*pivot.Y,
+	}
+}
+
+// Apply transforms a point.
+func (t Transform2) Apply(p Point2) Point2 {
+	return Point2{
+		X: t.Cos*p.X - t.Sin*p.Y + t.TX,
+		Y: t.Sin*p.X + t.Cos*p.Y + t.TY,
+	}
+}
+
+// Then composes two transforms: the receiver is applied first, then next.
+func (t Transform2) Then(next Transform2) Transform2 {
+	return Transform2{
+		Cos: next.Cos*t.Cos - next.Sin*t.Sin,
+		Sin: next.Sin*t.Cos + next.Cos*t.Sin,
+		TX:  next.Cos*t.TX - next.Sin*t.TY + next.TX,
+		TY:  next.Sin*t.TX + next.Cos*t.TY + next.TY,
+	}
+}
+
+// ProfileSegment is one straight span of the flat sheet cross-section,
+// delimited by the sheet edges and the bend line positions.
+type ProfileSegment struct {
+	Start, End Point2 // Endpoints in the flat (unbent) sheet frame.
+}
+
+// SheetProfile is the flat cross-section geometry of a sheet, split at each
+// bend position. It is computed once per job and shared by all folded states.
+type SheetProfile struct {
+	Segments []ProfileSegment
+}
+
+// NewSheetProfile builds the shared flat geometry for a sheet and its steps.
+// Steps only contribute their bend positions here; angles are applied later
+// as per-state transforms.
+func NewSheetProfile(sheet *SheetMetal, steps []*BendStep) (*SheetProfile, error) {
+	if sheet == nil {
+		return nil, fmt.Errorf("sheet is nil for profile construction")
+	}
+	positions := make([]float64, 0, len(steps))
+	for _, step := range steps {
+		if step == nil {
+			continue
+		}
+		positions = append(positions, step.Position)
+	}
+	// Insertion sort: bend counts are small and steps are usually near-ordered.
+	for i := 1; i < len(positions); i++ {
+		for j := i; j > 0 && positions[j] < positions[j-1]; j-- {
+			positions[j], positions[j-1] = positions[j-1], positions[j]
+		}
+	}
+
+	segs := make([]ProfileSegment, 0, len(positions)+1)
+	prev := 0.0
+	for _, pos := range positions {
+		if pos <= prev || pos >= sheet.OriginalLength {
+			continue // Out-of-range or duplicate positions add no split.
+		}
+		segs = append(segs, ProfileSegment{Start: Point2{X: prev}, End: Point2{X: pos}})
+		prev = pos
+	}
+	segs = append(segs, ProfileSegment{Start: Point2{X: prev}, End: Point2{X: sheet.OriginalLength}})
+	return &SheetProfile{Segments: segs}, nil
+}
+
+// FoldedState is the sheet geometry after some number of applied bends,
+// represented as one transform per shared segment.
+type FoldedState struct {
+	Profile    *SheetProfile // Shared, immutable flat geometry.
+	Transforms []Transform2  // One entry per Profile.Segments.
+}
+
+// Segment returns the transformed endpoints of segment i in this state.
+func (fs *FoldedState) Segment(i int) (Point2, Point2) {
+	seg := fs.Profile.Segments[i]
+	t := fs.Transforms[i]
+	return t.Apply(seg.Start), t.Apply(seg.End)
+}
+
+// BendLinePoint returns the current location of the bend line at flat
+// position x: the flat point mapped through the transform of the segment
+// containing it. Earlier folds move bend lines along with the material, so
+// this is generally not (x, 0).
+func (fs *FoldedState) BendLinePoint(x float64) Point2 {
+	for i, seg := range fs.Profile.Segments {
+		if seg.End.X >= x {
+			return fs.Transforms[i].Apply(Point2{X: x})
+		}
+	}
+	return Point2{X: x}
+}
+
+// FoldedStates computes the sequence of intermediate states for a job, one
+// per applied step (index 0 is the flat sheet). All states share the same
+// profile; each additional state only allocates its transform slice.
+func FoldedStates(sheet *SheetMetal, steps []*BendStep) ([]*FoldedState, error) {
+	profile, err := NewSheetProfile(sheet, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	flat := make([]Transform2, len(profile.Segments))
+	for i := range flat {
+		flat[i] = IdentityTransform()
+	}
+	states := []*FoldedState{{Profile: profile, Transforms: flat}}
+
+	current := flat
+	for _, step := range steps {
+		if step == nil {
+			continue
+		}
+		next := make([]Transform2, len(current))
+		copy(next, current)
+
+		// Fold everything beyond the bend position about the bend line. The
+		// bend line has moved with any earlier folds, so the pivot is its
+		// current location in the state built so far, not the flat point:
+		// rotating about (Position, 0) after another bend has already swung
+		// the material away would tear the part apart at the hinge.
+		fold := math.Pi - step.TargetAngle*math.Pi/180.0
+		if step.Direction == BendDirectionDown {
+			fold = -fold
+		}
+		first := -1
+		for i, seg := range profile.Segments {
+			if seg.Start.X >= step.Position {
+				first = i
+				break
+			}
+		}
+		if first >= 0 {
+			pivot := next[first].Apply(Point2{X: step.Position})
+			rot := RotationAbout(pivot, fold)
+			for i := first; i < len(profile.Segments); i++ {
+				next[i] = next[i].Then(rot)
+			}
+		}
+		states = append(states, &FoldedState{Profile: profile, Transforms: next})
+	}
+	return states, nil
+}
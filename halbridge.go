@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+)
+
+// --- LinuxCNC / HAL bridge (experimental) ---
+// Hobbyists building DIY brakes on LinuxCNC can use the simulator as an
+// offline programmer: the bridge streams the simulated program, one bend
+// command per line, over TCP to a HAL userspace component (or a mock
+// controller). The wire format is deliberately trivial:
+//
+//   HELLO <job name>
+//   CORR <position offset mm> <angle offset deg>   (only when dialed in)
+//   BEND <seq> <position mm> <angle deg> <radius mm> <UP|DOWN>
+//   BYE
+//
+// The peer answers "OK" (or "ERR <reason>") per line.
+
+// HALBridge streams bend programs to a LinuxCNC/HAL endpoint.
+type HALBridge struct {
+	Addr    string        // host:port of the HAL component / mock controller.
+	Timeout time.Duration // Per-connection I/O timeout.
+}
+
+// NewHALBridge returns a bridge for the given TCP address.
+func NewHALBridge(addr string) *HALBridge {
+	return &HALBridge{Addr: addr, Timeout: 10 * time.Second}
+}
+
+// StreamJob sends the job's bend sequence to the connected controller.
+// Cancelling ctx aborts the transfer between commands.
+func (hb *HALBridge) StreamJob(ctx context.Context, j *Job) error {
+	if j == nil {
+		return fmt.Errorf("job is nil for HAL streaming")
+	}
+	if len(j.Steps) == 0 {
+		return fmt.Errorf("job '%s' has no steps to stream", j.Name)
+	}
+
+	dialer := net.Dialer{Timeout: hb.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", hb.Addr)
+	if err != nil {
+		return fmt.Errorf("connecting to HAL bridge at '%s': %w", hb.Addr, err)
+	}
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	send := func(line string) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := conn.SetDeadline(time.Now().Add(hb.Timeout)); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(conn, "%s\n", line); err != nil {
+			return fmt.Errorf("sending '%s': %w", line, err)
+		}
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("reading reply to '%s': %w", line, err)
+		}
+		reply = strings.TrimSpace(reply)
+		if !strings.HasPrefix(reply, "OK") {
+			return fmt.Errorf("controller rejected '%s': %s", line, reply)
+		}
+		return nil
+	}
+
+	lines, err := BendProgramLines(j)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if err := send(line); err != nil {
+			return err
+		}
+	}
+	log.Printf("INFO: Streamed job '%s' (%d steps) to HAL bridge at '%s'.", j.Name, len(j.Steps), hb.Addr)
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Hemming (two-stage) sequence generation ---
+// A hem is produced in two hits: an acute pre-bend (typically to ~30°
+// internal) in an acute tooling station, then flattening the folded edge in
+// a flattening station. Users request "a hem at X"; the generator inserts
+// both sub-steps with the correct stations instead of forcing manual entry.
+
+// Tool station names used by generated hem steps.
+const (
+	StationAcute      = "Acute"      // Acute punch/die station for the pre-bend.
+	StationFlattening = "Flattening" // Flattening die station for the second hit.
+)
+
+// hemPreBendAngle is the internal angle of the acute pre-bend hit.
+const hemPreBendAngle = 30.0
+
+// AddHemToCurrentJob appends the two sub-steps of a hem (pre-bend then
+// flatten) at the given position. Both steps share the position and
+// direction; the flatten hit closes the fold as far as the angle model
+// currently allows.
+func (jc *JobController) AddHemToCurrentJob(pos float64, dir BendDirection) ([]*BendStep, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job selected")
+	}
+	if jc.currentJob.Sheet == nil {
+		return nil, fmt.Errorf("current job has no sheet defined")
+	}
+	sheet := jc.currentJob.Sheet
+	if pos <= 0 || pos >= sheet.OriginalLength {
+		return nil, fmt.Errorf("hem position (%.2fmm) is outside sheet length (0-%.2fmm)", pos, sheet.OriginalLength)
+	}
+
+	// Pre-bend: acute hit at the hem line with a radius suited to the material.
+	preBend := &BendStep{
+		SequenceOrder: len(jc.currentJob.Steps) + 1,
+		Position:      pos,
+		TargetAngle:   hemPreBendAngle,
+		Radius:        sheet.GetMinBendRadiusFor(BendTransverse),
+		Direction:     dir,
+		Station:       StationAcute,
+	}
+	// Flatten: close the fold completely; the flattening band of the angle
+	// model (see flattening.go) carries its own allowance and force rules.
+	flatten := &BendStep{
+		SequenceOrder: len(jc.currentJob.Steps) + 2,
+		Position:      pos,
+		TargetAngle:   maxFlatteningAngleDeg,
+		Radius:        sheet.Thickness * 0.5, // Inside radius of a closed hem.
+		Direction:     dir,
+		Station:       StationFlattening,
+	}
+
+	jc.currentJob.Steps = append(jc.currentJob.Steps, preBend, flatten)
+	jc.InvalidateStepsFrom(preBend.SequenceOrder - 1)
+	log.Printf("INFO: Added hem at %.1fmm to job '%s': pre-bend (step %d, station %s) + flatten (step %d, station %s).",
+		pos, jc.currentJob.Name, preBend.SequenceOrder, preBend.Station, flatten.SequenceOrder, flatten.Station)
+	return []*BendStep{preBend, flatten}, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- HVAC duct fitting generators ---
+// Duct shops bend the same few profiles all day: Pittsburgh-lock seam
+// channels, TDC/TDF connection flanges, and simple transitions broken
+// into segment bends. The generators below produce ready-made sheets and
+// sequences from the profile dimensions, using typical HVAC defaults
+// (light-gauge material, generous radii, up-bends throughout).
+
+// Pittsburgh lock pocket geometry, per the common hand-brake dimensions.
+const (
+	pittsburghPocketMM = 8.0  // Pocket depth of the female lock.
+	pittsburghTailMM   = 6.0  // Return tail folded back over the pocket.
+	tdcFlangeMM        = 25.4 // Standing TDC flange height (1 inch).
+	hvacBendRadiusMM   = 1.0
+)
+
+// GeneratePittsburghChannel builds a job forming the female Pittsburgh
+// lock along one edge of a duct side: web width plus the pocket and tail
+// folds. Width is the seam length (duct section length).
+func GeneratePittsburghChannel(name string, webMM, seamLengthMM, thicknessMM float64, material MaterialDetails) (*Job, error) {
+	if webMM <= pittsburghPocketMM+pittsburghTailMM {
+		return nil, fmt.Errorf("web %.1fmm is too narrow for a Pittsburgh lock (needs > %.1fmm)", webMM, pittsburghPocketMM+pittsburghTailMM)
+	}
+	blank := webMM + pittsburghPocketMM + pittsburghTailMM
+	sheet, err := NewSheetMetal(name, blank, seamLengthMM, thicknessMM, material)
+	if err != nil {
+		return nil, fmt.Errorf("pittsburgh channel sheet: %w", err)
+	}
+	job, err := NewJob(name, sheet)
+	if err != nil {
+		return nil, err
+	}
+	// Fold sequence from the edge inward: tail hem first, then the pocket.
+	job.Steps = []*BendStep{
+		{SequenceOrder: 1, Position: blank - pittsburghTailMM, TargetAngle: maxBendAngle, Radius: thicknessMM * 0.5, Direction: BendDirectionUp, Station: StationAcute},
+		{SequenceOrder: 2, Position: blank - pittsburghTailMM - pittsburghPocketMM, TargetAngle: maxBendAngle, Radius: thicknessMM * 0.5, Direction: BendDirectionDown, Station: StationAcute},
+	}
+	log.Printf("INFO: Generated Pittsburgh channel '%s' (blank %.1fmm).", name, blank)
+	return job, nil
+}
+
+// GenerateTDCFlange builds a job adding standing TDC flanges to both ends
+// of a duct side panel.
+func GenerateTDCFlange(name string, panelMM, seamLengthMM, thicknessMM float64, material MaterialDetails) (*Job, error) {
+	blank := panelMM + 2*tdcFlangeMM
+	sheet, err := NewSheetMetal(name, blank, seamLengthMM, thicknessMM, material)
+	if err != nil {
+		return nil, fmt.Errorf("tdc flange sheet: %w", err)
+	}
+	job, err := NewJob(name, sheet)
+	if err != nil {
+		return nil, err
+	}
+	job.Steps = []*BendStep{
+		{SequenceOrder: 1, Position: tdcFlangeMM, TargetAngle: 90, Radius: hvacBendRadiusMM, Direction: BendDirectionUp},
+		{SequenceOrder: 2, Position: blank - tdcFlangeMM, TargetAngle: 90, Radius: hvacBendRadiusMM, Direction: BendDirectionUp},
+	}
+	log.Printf("INFO: Generated TDC flange panel '%s' (blank %.1fmm).", name, blank)
+	return job, nil
+}
+
+// GenerateTransition approximates one side of a rectangular-to-rectangular
+// transition as a series of shallow segment bends (a bump-formed wedge).
+// largeMM and smallMM are the edge lengths at the two ends; segments is
+// the number of facet bends.
+func GenerateTransition(name string, largeMM, smallMM, depthMM, thicknessMM float64, segments int, material MaterialDetails) (*Job, error) {
+	if largeMM <= smallMM {
+		return nil, fmt.Errorf("large end %.1fmm must exceed small end %.1fmm", largeMM, smallMM)
+	}
+	if segments < 2 {
+		segments = 2
+	}
+	sheet, err := NewSheetMetal(name, largeMM, depthMM, thicknessMM, material)
+	if err != nil {
+		return nil, fmt.Errorf("transition sheet: %w", err)
+	}
+	job, err := NewJob(name, sheet)
+	if err != nil {
+		return nil, err
+	}
+	// Shallow equal bends spread across the tapering region approximate
+	// the transition; the per-segment angle splits the total taper.
+	taperPerSide := (largeMM - smallMM) / 2
+	segmentAngle := 180.0 - taperPerSide/float64(segments)
+	if segmentAngle < minBendAngle {
+		segmentAngle = minBendAngle
+	}
+	spacing := largeMM / float64(segments+1)
+	for i := 0; i < segments; i++ {
+		job.Steps = append(job.Steps, &BendStep{
+			SequenceOrder: i + 1,
+			Position:      spacing * float64(i+1),
+			TargetAngle:   segmentAngle,
+			Radius:        hvacBendRadiusMM,
+			Direction:     BendDirectionUp,
+		})
+	}
+	log.Printf("INFO: Generated transition '%s' (%d segment bends).", name, segments)
+	return job, nil
+}
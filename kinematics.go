@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// --- Machine kinematics import ---
+// Controller backups already contain the axis data (speeds, accelerations,
+// travel limits) that machine profiles need; importing them beats manual
+// entry. Two formats are supported: a JSON dump, and the common
+// "AXIS.PARAM=value" key/value text format used by several controller
+// backup tools.
+
+// AxisKinematics holds the motion parameters of one machine axis.
+type AxisKinematics struct {
+	Name        string  `json:"name"`
+	MaxSpeedMMS float64 `json:"max_speed_mms"` // Rapid speed, mm/s.
+	AccelMMS2   float64 `json:"accel_mms2"`    // Acceleration, mm/s^2.
+	MinPosMM    float64 `json:"min_pos_mm"`    // Lower travel limit.
+	MaxPosMM    float64 `json:"max_pos_mm"`    // Upper travel limit.
+}
+
+// MachineKinematics is the set of axes read from a controller backup.
+type MachineKinematics struct {
+	Axes map[string]*AxisKinematics `json:"axes"`
+}
+
+// ImportKinematicsFile reads a kinematics file, auto-detecting the format
+// from the extension: .json is decoded directly, anything else is parsed as
+// AXIS.PARAM=value lines ('#' starts a comment).
+func ImportKinematicsFile(path string) (*MachineKinematics, error) {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return importKinematicsJSON(path)
+	}
+	return importKinematicsKeyValue(path)
+}
+
+func importKinematicsJSON(path string) (*MachineKinematics, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading kinematics file: %w", err)
+	}
+	mk := &MachineKinematics{}
+	if err := json.Unmarshal(data, mk); err != nil {
+		return nil, fmt.Errorf("decoding kinematics JSON '%s': %w", path, err)
+	}
+	for name, axis := range mk.Axes {
+		if axis.Name == "" {
+			axis.Name = name
+		}
+	}
+	log.Printf("INFO: Imported kinematics for %d axis/axes from '%s' (JSON).", len(mk.Axes), path)
+	return mk, nil
+}
+
+func importKinematicsKeyValue(path string) (*MachineKinematics, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening kinematics file: %w", err)
+	}
+	defer f.Close()
+
+	mk := &MachineKinematics{Axes: make(map[string]*AxisKinematics)}
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		eq := strings.IndexByte(line, '=')
+		dot := strings.IndexByte(line, '.')
+		if eq < 0 || dot < 0 || dot > eq {
+			log.Printf("WARNING: Kinematics '%s' line %d: unrecognized entry '%s' skipped.", path, lineNo, line)
+			continue
+		}
+		axisName := strings.TrimSpace(line[:dot])
+		param := strings.ToLower(strings.TrimSpace(line[dot+1 : eq]))
+		value, vErr := strconv.ParseFloat(strings.TrimSpace(line[eq+1:]), 64)
+		if vErr != nil {
+			log.Printf("WARNING: Kinematics '%s' line %d: bad value in '%s' skipped.", path, lineNo, line)
+			continue
+		}
+		axis, ok := mk.Axes[axisName]
+		if !ok {
+			axis = &AxisKinematics{Name: axisName}
+			mk.Axes[axisName] = axis
+		}
+		switch param {
+		case "speed", "max_speed":
+			axis.MaxSpeedMMS = value
+		case "accel", "acceleration":
+			axis.AccelMMS2 = value
+		case "min", "min_pos":
+			axis.MinPosMM = value
+		case "max", "max_pos":
+			axis.MaxPosMM = value
+		default:
+			log.Printf("WARNING: Kinematics '%s' line %d: unknown parameter '%s' skipped.", path, lineNo, param)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading kinematics file '%s': %w", path, err)
+	}
+	log.Printf("INFO: Imported kinematics for %d axis/axes from '%s' (key/value).", len(mk.Axes), path)
+	return mk, nil
+}
+
+// ApplyKinematics stores imported kinematics on the machine profile.
+func (pb *PressBrake) ApplyKinematics(mk *MachineKinematics) error {
+	if err := RequireConfigUnlocked("machine profile editing"); err != nil {
+		return err
+	}
+	if mk == nil || len(mk.Axes) == 0 {
+		return fmt.Errorf("no axis data to apply")
+	}
+	pb.Kinematics = mk
+	log.Printf("INFO: PressBrake '%s': kinematics applied (%d axes).", pb.Name, len(mk.Axes))
+	return nil
+}
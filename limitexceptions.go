@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// --- Per-job limit exceptions ---
+// Warning overrides (overrides.go) let an operator accept a recommendation;
+// this is the stronger case: a job that genuinely needs to pass a hard
+// global limit — an over-length sheet that fits one particular machine,
+// say. Rather than loosening the limit for everyone, the exception rides
+// on the job: which rule, up to what value, who approved it and why.
+// Granting one is a configuration-level act and requires the admin lock
+// to be open.
+
+// LimitException is one approved deviation from a global validation limit.
+type LimitException struct {
+	RuleID     string
+	MaxValue   float64 // Highest value the exception admits for this rule.
+	ApprovedBy string
+	Reason     string
+	When       time.Time
+}
+
+// GrantLimitException records an approved exception on the job. It
+// refuses while the configuration is locked, and insists on an approver
+// and a reason — an unexplained exception is worthless in an audit.
+func (j *Job) GrantLimitException(ruleID string, maxValue float64, approvedBy, reason string) error {
+	if err := RequireConfigUnlocked("granting a limit exception"); err != nil {
+		return err
+	}
+	if strings.TrimSpace(approvedBy) == "" || strings.TrimSpace(reason) == "" {
+		return fmt.Errorf("a limit exception needs both an approver and a reason")
+	}
+	if _, ok := GetActiveRuleSet().Get(ruleID); !ok {
+		return fmt.Errorf("unknown rule '%s' for limit exception", ruleID)
+	}
+	j.LimitExceptions = append(j.LimitExceptions, LimitException{
+		RuleID:     ruleID,
+		MaxValue:   maxValue,
+		ApprovedBy: approvedBy,
+		Reason:     reason,
+		When:       time.Now(),
+	})
+	log.Printf("INFO: Job '%s': limit exception for %s up to %.2f approved by %s (%s).",
+		j.Name, ruleID, maxValue, approvedBy, reason)
+	return nil
+}
+
+// exceptionFor returns the job's exception for a rule covering the given
+// value, if one exists.
+func (j *Job) exceptionFor(ruleID string, value float64) *LimitException {
+	if j == nil {
+		return nil
+	}
+	for i := range j.LimitExceptions {
+		ex := &j.LimitExceptions[i]
+		if ex.RuleID == ruleID && value <= ex.MaxValue {
+			return ex
+		}
+	}
+	return nil
+}
+
+// CheckWithExceptions evaluates a rule for a job, honoring any approved
+// exception the job carries. An admitted value is logged so the pass
+// leaves a trace.
+func CheckWithExceptions(j *Job, ruleID string, value float64) error {
+	err := GetActiveRuleSet().Check(ruleID, value)
+	if err == nil {
+		return nil
+	}
+	if ex := j.exceptionFor(ruleID, value); ex != nil {
+		log.Printf("INFO: Job '%s': %s value %.2f admitted under exception approved by %s.",
+			j.Name, ruleID, value, ex.ApprovedBy)
+		return nil
+	}
+	return err
+}
+
+// limitExceptionBlock renders the job's exceptions for the setup sheet
+// ("" when there are none).
+func limitExceptionBlock(j *Job) string {
+	if j == nil || len(j.LimitExceptions) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\nApproved Limit Exceptions (%d):\n", len(j.LimitExceptions)))
+	for _, ex := range j.LimitExceptions {
+		sb.WriteString(fmt.Sprintf("  [%s] up to %.2f - %s (approved by %s, %s)\n",
+			ex.RuleID, ex.MaxValue, ex.Reason, ex.ApprovedBy, ex.When.Format("2006-01-02")))
+	}
+	return sb.String()
+}
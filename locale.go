@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// --- Number localization ---
+// European operators type "2,5" and should not see a parse error for it.
+// User-facing editors parse through ParseUserFloat, which accepts either
+// separator; outputs format through LocalizeNumber when comma output is
+// enabled for the install. File formats with fixed grammars (DXF, CSV
+// tables, key=value imports) keep the dot — those are machine interfaces,
+// not user input.
+
+var (
+	localeMu     sync.RWMutex
+	decimalComma bool // Format outputs with a comma separator.
+)
+
+// SetDecimalComma switches output formatting to the comma convention.
+func SetDecimalComma(enabled bool) {
+	localeMu.Lock()
+	decimalComma = enabled
+	localeMu.Unlock()
+}
+
+// DecimalCommaEnabled reports whether outputs use the comma convention.
+func DecimalCommaEnabled() bool {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	return decimalComma
+}
+
+// ParseUserFloat parses a number typed by the user, accepting both "2.5"
+// and "2,5". A value with both separators (thousands notation) is
+// rejected rather than guessed at.
+func ParseUserFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, ",") && strings.Contains(s, ".") {
+		return 0, fmt.Errorf("ambiguous number '%s': use a single decimal separator", s)
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(s, ",", "."), 64)
+}
+
+// LocalizeNumber rewrites a formatted number for display, applying the
+// comma convention when enabled.
+func LocalizeNumber(s string) string {
+	if !DecimalCommaEnabled() {
+		return s
+	}
+	return strings.ReplaceAll(s, ".", ",")
+}
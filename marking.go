@@ -0,0 +1,99 @@
+package main
+
+import "fmt"
+
+// --- Die marking / scratch prediction ---
+// Sheet sliding over the die shoulders during the stroke leaves witness
+// marks; on cosmetic stainless or pre-painted material that scraps parts.
+// Risk rises with small shoulder radii and soft material, and drops with
+// protective film or a urethane pad over the die.
+
+// SurfaceProtection describes what sits between die and sheet.
+type SurfaceProtection string
+
+const (
+	ProtectionNone     SurfaceProtection = "None"
+	ProtectionFilm     SurfaceProtection = "Film"     // PVC/laser film on the sheet.
+	ProtectionUrethane SurfaceProtection = "Urethane" // Urethane pad/insert in the die.
+)
+
+// MarkingRisk grades the predicted die marking severity.
+type MarkingRisk int
+
+const (
+	MarkingRiskLow MarkingRisk = iota
+	MarkingRiskMedium
+	MarkingRiskHigh
+)
+
+// String returns the display name of the risk grade.
+func (r MarkingRisk) String() string {
+	switch r {
+	case MarkingRiskHigh:
+		return "High"
+	case MarkingRiskMedium:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+// MarkingAssessment is the predicted marking outcome for a sheet/die combo.
+type MarkingAssessment struct {
+	Risk    MarkingRisk
+	Message string
+}
+
+// AssessMarkingRisk predicts die-shoulder marking for the given sheet, die
+// and protection. Soft material (low yield stress) against a sharp shoulder
+// marks worst; urethane practically eliminates it, film greatly reduces it.
+func AssessMarkingRisk(sheet *SheetMetal, die *Die, protection SurfaceProtection) (*MarkingAssessment, error) {
+	if sheet == nil || die == nil {
+		return nil, fmt.Errorf("sheet or die is nil for marking assessment")
+	}
+
+	// Score 0 (no marking) .. ~3 (severe). Shoulder radius relative to
+	// thickness dominates; material softness scales it.
+	score := 0.0
+	if sheet.Thickness > 0 {
+		ratio := die.ShoulderRadius / sheet.Thickness
+		switch {
+		case ratio < 1:
+			score = 2.5
+		case ratio < 2:
+			score = 1.5
+		default:
+			score = 0.8
+		}
+	}
+	if sheet.Material.YieldStress > 0 && sheet.Material.YieldStress < 150 {
+		score *= 1.4 // Soft aluminum/copper marks visibly sooner.
+	}
+	switch protection {
+	case ProtectionUrethane:
+		score *= 0.1
+	case ProtectionFilm:
+		score *= 0.4
+	}
+	// Higher sliding friction drags harder on the shoulders and deepens marks.
+	score *= FrictionFactorFor(sheet.Material, die)
+
+	assess := &MarkingAssessment{}
+	switch {
+	case score >= 2.0:
+		assess.Risk = MarkingRiskHigh
+	case score >= 1.0:
+		assess.Risk = MarkingRiskMedium
+	default:
+		assess.Risk = MarkingRiskLow
+	}
+
+	if sheet.Cosmetic && assess.Risk != MarkingRiskLow {
+		assess.Message = fmt.Sprintf("Cosmetic sheet: %s marking risk with die '%s' (shoulder R%.1fmm, protection: %s). Consider urethane or a larger shoulder radius.",
+			assess.Risk, die.Name, die.ShoulderRadius, protection)
+	} else if assess.Risk == MarkingRiskHigh {
+		assess.Message = fmt.Sprintf("High die marking risk with die '%s' (shoulder R%.1fmm, protection: %s).",
+			die.Name, die.ShoulderRadius, protection)
+	}
+	return assess, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import "fmt"
+
+// --- Minimum flange length vs die opening ---
+// A flange shorter than the die can support slips into the V during the
+// stroke: the edge dives past the shoulder, the bend lands in the wrong
+// place and the part can be thrown. The usual shop rule puts the minimum
+// at a fixed multiple of the V opening; both flanges of a prospective
+// bend are checked against the installed die before the step is accepted.
+
+// Rule ID for the minimum flange length warning.
+const RuleMinFlangeWarn = "BEND.FLANGE.MIN"
+
+// minFlangeVFactor is the minimum flange length as a multiple of the die
+// V opening (the common 0.7V rule for 85-90° dies).
+const minFlangeVFactor = 0.7
+
+// MinFlangeForDie returns the shortest flange the die can support (0 when
+// no die or V opening is known).
+func MinFlangeForDie(die *Die) float64 {
+	if die == nil || die.VOpening <= 0 {
+		return 0
+	}
+	return die.VOpening * minFlangeVFactor
+}
+
+// CheckMinFlange examines the two flanges a bend at pos would leave and
+// returns a warning message when either is too short for the die ("" when
+// the bend sits safely on the shoulders).
+func CheckMinFlange(sheet *SheetMetal, pos float64, die *Die) string {
+	if sheet == nil {
+		return ""
+	}
+	min := MinFlangeForDie(die)
+	if min <= 0 {
+		return ""
+	}
+	front, rear := pos, sheet.OriginalLength-pos
+	short := 0.0
+	switch {
+	case front < min && rear < min:
+		short = front
+		if rear < front {
+			short = rear
+		}
+	case front < min:
+		short = front
+	case rear < min:
+		short = rear
+	default:
+		return ""
+	}
+	return fmt.Sprintf("Flange of %.1fmm is below the %.1fmm minimum for die '%s' (V%.0f): edge may slip into the V.",
+		short, min, die.Name, die.VOpening)
+}
@@ -0,0 +1,49 @@
+package main
+
+// --- Orientation/temper-aware minimum bend radius ---
+// A single MinBendRadiusFactor badly misleads aluminum users: the safe
+// minimum radius depends on the temper (5052-H32 bends much tighter than
+// H34) and on whether the bend line runs across or along the rolling grain.
+// Materials can now carry a per-orientation factor table; the old single
+// factor remains the fallback for materials without one.
+
+// BendOrientation describes the bend line relative to the rolling direction.
+type BendOrientation string
+
+const (
+	// BendTransverse: bend line perpendicular to the grain (the forgiving case).
+	BendTransverse BendOrientation = "Transverse"
+	// BendLongitudinal: bend line parallel to the grain (cracks first).
+	BendLongitudinal BendOrientation = "Longitudinal"
+)
+
+// MinRadiusSpec holds minimum-bend-radius factors (times thickness) per
+// bend orientation for a specific material and temper.
+type MinRadiusSpec struct {
+	Transverse   float64 // Factor for bends across the grain.
+	Longitudinal float64 // Factor for bends along the grain.
+}
+
+// FactorFor returns the factor for the given orientation, defaulting to the
+// conservative (longitudinal) value for unknown orientations.
+func (spec MinRadiusSpec) FactorFor(o BendOrientation) float64 {
+	if o == BendTransverse {
+		return spec.Transverse
+	}
+	return spec.Longitudinal
+}
+
+// GetMinBendRadiusFor calculates the recommended minimum bend radius for a
+// given bend orientation, using the material's orientation table when one is
+// defined and falling back to the legacy single factor otherwise.
+func (s *SheetMetal) GetMinBendRadiusFor(o BendOrientation) float64 {
+	if s.Thickness <= 0 {
+		return 0
+	}
+	if s.Material.MinRadius != nil {
+		if f := s.Material.MinRadius.FactorFor(o); f > 0 {
+			return s.Thickness * f
+		}
+	}
+	return s.GetMinBendRadius()
+}
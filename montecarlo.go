@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// --- Monte Carlo / batch simulation ---
+// Tolerance studies run the same job many times with small random variations
+// of the input (thickness, yield stress) to estimate the spread of outcomes.
+// Trials are distributed over a worker pool sized to GOMAXPROCS so that
+// thousand-part runs stay interactive, and a context allows cancellation.
+
+// MonteCarloParams configures a stochastic batch run.
+type MonteCarloParams struct {
+	Trials             int     // Number of simulated parts.
+	ThicknessTolerance float64 // +/- mm applied uniformly to sheet thickness.
+	YieldTolerance     float64 // +/- fraction (e.g. 0.05 for 5%) applied to yield stress.
+	Seed               int64   // Seed for reproducible runs; 0 picks a default.
+}
+
+// MonteCarloTrial is the outcome of a single simulated part.
+type MonteCarloTrial struct {
+	Thickness   float64 // Perturbed thickness used for this trial (mm).
+	YieldStress float64 // Perturbed yield stress used for this trial (MPa).
+	AngleError  float64 // Estimated deviation from target angle (degrees).
+}
+
+// MonteCarloResult aggregates the outcomes of a batch run.
+type MonteCarloResult struct {
+	Completed     int // Trials actually run (may be < Trials if cancelled).
+	Cancelled     bool
+	MinAngleError float64
+	MaxAngleError float64
+	AvgAngleError float64
+}
+
+// trialAngleError estimates the angle deviation for one perturbed part.
+// The model is deliberately simple (deviation proportional to the relative
+// thickness and yield error); it will be refined as the physics engine grows.
+func trialAngleError(sheet *SheetMetal, thickness, yield float64) float64 {
+	if sheet == nil || sheet.Thickness <= 0 || sheet.Material.YieldStress <= 0 {
+		return 0
+	}
+	dt := (thickness - sheet.Thickness) / sheet.Thickness
+	dy := (yield - sheet.Material.YieldStress) / sheet.Material.YieldStress
+	// Thicker or harder material springs back more; scale into degrees.
+	return (dt*1.5 + dy*0.8) * 2.0
+}
+
+// RunMonteCarlo executes a stochastic batch simulation of the given job.
+// Trials are spread across GOMAXPROCS worker goroutines; cancelling ctx stops
+// the run early and returns the aggregate of the trials completed so far.
+func RunMonteCarlo(ctx context.Context, job *Job, params MonteCarloParams) (*MonteCarloResult, error) {
+	if err := RequireFeature(FeatureMonteCarlo); err != nil {
+		return nil, err
+	}
+	if job == nil || job.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for Monte Carlo run")
+	}
+	if params.Trials <= 0 {
+		return nil, fmt.Errorf("trial count must be positive (got %d)", params.Trials)
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > params.Trials {
+		workers = params.Trials
+	}
+	seed := params.Seed
+	if seed == 0 {
+		seed = 1
+	}
+
+	trialCh := make(chan MonteCarloTrial, workers*4)
+	var wg sync.WaitGroup
+	perWorker := params.Trials / workers
+	extra := params.Trials % workers
+
+	for w := 0; w < workers; w++ {
+		count := perWorker
+		if w < extra {
+			count++
+		}
+		wg.Add(1)
+		go func(workerID, count int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(seed + int64(workerID)))
+			for i := 0; i < count; i++ {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				t := job.Sheet.Thickness + (rng.Float64()*2-1)*params.ThicknessTolerance
+				y := job.Sheet.Material.YieldStress * (1 + (rng.Float64()*2-1)*params.YieldTolerance)
+				trialCh <- MonteCarloTrial{
+					Thickness:   t,
+					YieldStress: y,
+					AngleError:  trialAngleError(job.Sheet, t, y),
+				}
+			}
+		}(w, count)
+	}
+
+	go func() {
+		wg.Wait()
+		close(trialCh)
+	}()
+
+	res := &MonteCarloResult{}
+	sum := 0.0
+	for trial := range trialCh {
+		if res.Completed == 0 {
+			res.MinAngleError, res.MaxAngleError = trial.AngleError, trial.AngleError
+		} else {
+			if trial.AngleError < res.MinAngleError {
+				res.MinAngleError = trial.AngleError
+			}
+			if trial.AngleError > res.MaxAngleError {
+				res.MaxAngleError = trial.AngleError
+			}
+		}
+		sum += trial.AngleError
+		res.Completed++
+	}
+	if res.Completed > 0 {
+		res.AvgAngleError = sum / float64(res.Completed)
+	}
+	res.Cancelled = ctx.Err() != nil
+
+	log.Printf("INFO: Monte Carlo run for job '%s': %d/%d trials (%d workers), angle error min %.3f° avg %.3f° max %.3f°.",
+		job.Name, res.Completed, params.Trials, workers, res.MinAngleError, res.AvgAngleError, res.MaxAngleError)
+	return res, nil
+}
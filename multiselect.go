@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// --- Multi-select bulk operations on bend steps ---
+// A drawing revision rarely moves one feature; it moves a group. Steps
+// Ctrl-clicked in the sequence list join a selection set, and the bulk
+// bar shifts, re-radiuses, flips or deletes the whole set in one edit,
+// with every touched step revalidated against the sheet first.
+
+// toggleMultiSelect adds or removes a step index from the selection set.
+func (ac *AppController) toggleMultiSelect(i int) {
+	if ac.multiSelected == nil {
+		ac.multiSelected = make(map[int]bool)
+	}
+	if ac.multiSelected[i] {
+		delete(ac.multiSelected, i)
+	} else {
+		ac.multiSelected[i] = true
+	}
+}
+
+// clearMultiSelect empties the selection set.
+func (ac *AppController) clearMultiSelect() {
+	ac.multiSelected = nil
+}
+
+// multiSelectedSteps returns the selected, still-valid steps in list order.
+func (ac *AppController) multiSelectedSteps() ([]int, []*BendStep) {
+	if ac.currentJob == nil {
+		return nil, nil
+	}
+	var idxs []int
+	for i := range ac.multiSelected {
+		if i >= 0 && i < len(ac.currentJob.Steps) && ac.currentJob.Steps[i] != nil {
+			idxs = append(idxs, i)
+		}
+	}
+	sort.Ints(idxs)
+	steps := make([]*BendStep, len(idxs))
+	for k, i := range idxs {
+		steps[k] = ac.currentJob.Steps[i]
+	}
+	return idxs, steps
+}
+
+// bulkEditSelected revalidates then applies an edit to every selected
+// step. The whole group is rejected if any step would become invalid, so
+// a bad shift cannot half-apply.
+func (ac *AppController) bulkEditSelected(desc string, newParams func(*BendStep) (pos, angle, radius float64), apply func(*BendStep)) {
+	if ac.blockInViewerMode() {
+		return
+	}
+	idxs, steps := ac.multiSelectedSteps()
+	if len(steps) == 0 {
+		ac.updateStatus("No steps selected for bulk edit.", true)
+		return
+	}
+	for _, step := range steps {
+		pos, angle, radius := newParams(step)
+		if err := validateBendStepParams(ac.currentJob.Sheet, pos, angle, radius); err != nil {
+			ac.updateStatus(fmt.Sprintf("Bulk %s rejected at step %d: %v", desc, step.SequenceOrder, err), true)
+			return
+		}
+	}
+	for _, step := range steps {
+		apply(step)
+	}
+	ac.jobController.InvalidateStepsFrom(idxs[0])
+	ac.markJobDirty()
+	log.Printf("INFO: Bulk %s applied to %d step(s) of job '%s'.", desc, len(steps), ac.currentJob.Name)
+	ac.updateStatus(fmt.Sprintf("Bulk %s applied to %d step(s).", desc, len(steps)), false)
+}
+
+// shiftSelectedSteps moves all selected bend positions by dx mm.
+func (ac *AppController) shiftSelectedSteps(dx float64) {
+	ac.bulkEditSelected(fmt.Sprintf("shift %+.1fmm", dx),
+		func(s *BendStep) (float64, float64, float64) { return s.Position + dx, s.TargetAngle, s.Radius },
+		func(s *BendStep) { s.Position += dx })
+}
+
+// setSelectedRadius sets all selected bend radii to r mm.
+func (ac *AppController) setSelectedRadius(r float64) {
+	ac.bulkEditSelected(fmt.Sprintf("radius %.1fmm", r),
+		func(s *BendStep) (float64, float64, float64) { return s.Position, s.TargetAngle, r },
+		func(s *BendStep) { s.Radius = r })
+}
+
+// flipSelectedDirections inverts the bend sense of all selected steps.
+func (ac *AppController) flipSelectedDirections() {
+	ac.bulkEditSelected("direction flip",
+		func(s *BendStep) (float64, float64, float64) { return s.Position, s.TargetAngle, s.Radius },
+		func(s *BendStep) {
+			if s.Direction == BendDirectionUp {
+				s.Direction = BendDirectionDown
+			} else {
+				s.Direction = BendDirectionUp
+			}
+		})
+}
+
+// deleteSelectedSteps removes the selected steps from the job.
+func (ac *AppController) deleteSelectedSteps() {
+	if ac.blockInViewerMode() {
+		return
+	}
+	idxs, _ := ac.multiSelectedSteps()
+	if len(idxs) == 0 {
+		ac.updateStatus("No steps selected for deletion.", true)
+		return
+	}
+	ac.showConfirmDialog("Delete Selected Steps",
+		fmt.Sprintf("Remove %d selected step(s) from job '%s'?", len(idxs), ac.currentJob.Name),
+		func() {
+			kept := ac.currentJob.Steps[:0]
+			for i, step := range ac.currentJob.Steps {
+				if !ac.multiSelected[i] {
+					kept = append(kept, step)
+				}
+			}
+			ac.currentJob.Steps = kept
+			for k, step := range ac.currentJob.Steps {
+				if step != nil {
+					step.SequenceOrder = k + 1
+				}
+			}
+			ac.clearMultiSelect()
+			ac.selectedStepIdx = -1
+			ac.jobController.InvalidateStepsFrom(0)
+			ac.markJobDirty()
+			log.Printf("INFO: Deleted %d step(s) from job '%s'; %d remain.", len(idxs), ac.currentJob.Name, len(ac.currentJob.Steps))
+			ac.updateStatus(fmt.Sprintf("Deleted %d step(s).", len(idxs)), false)
+			ac.signalUIUpdate()
+		}, nil)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// --- Blank nesting export ---
+// Shops cut blanks on a laser or punch before bending. This export hands
+// all blanks of a batch to the nesting software in one go: a single DXF
+// with every flat pattern laid out and labeled, plus a manifest CSV so
+// the CAM operator knows part names, quantities and material without
+// opening the drawing.
+
+// nestingGapMM is the spacing left between blanks in the export layout.
+// The nesting software re-arranges parts anyway; the gap just keeps the
+// preview readable.
+const nestingGapMM = 20.0
+
+// NestingItem is one part in a nesting batch.
+type NestingItem struct {
+	Job      *Job
+	Quantity int
+}
+
+// dxfLine appends a LINE entity on layer to sb.
+func dxfLine(sb *strings.Builder, layer string, x1, y1, x2, y2 float64) {
+	fmt.Fprintf(sb, "0\nLINE\n8\n%s\n10\n%.3f\n20\n%.3f\n11\n%.3f\n21\n%.3f\n", layer, x1, y1, x2, y2)
+}
+
+// dxfText appends a TEXT entity on layer to sb.
+func dxfText(sb *strings.Builder, layer string, x, y, height float64, text string) {
+	fmt.Fprintf(sb, "0\nTEXT\n8\n%s\n10\n%.3f\n20\n%.3f\n40\n%.3f\n1\n%s\n", layer, x, y, height, text)
+}
+
+// ExportNestingDXF writes all blanks of the batch into one DXF at dxfPath
+// and a manifest CSV at csvPath. Blank sizes use the developed length from
+// the simulation summary so the cut part folds to the programmed profile.
+func ExportNestingDXF(items []NestingItem, dxfPath, csvPath string) error {
+	if len(items) == 0 {
+		return fmt.Errorf("no parts to nest")
+	}
+
+	var dxf strings.Builder
+	dxf.WriteString("0\nSECTION\n2\nENTITIES\n")
+
+	var csv strings.Builder
+	csv.WriteString("part,quantity,developed_length_mm,width_mm,thickness_mm,material\n")
+
+	yCursor := 0.0
+	for _, item := range items {
+		if item.Job == nil || item.Job.Sheet == nil {
+			return fmt.Errorf("nesting batch contains a job without a sheet")
+		}
+		qty := item.Quantity
+		if qty < 1 {
+			qty = 1
+		}
+		summary, err := SummarizeJobSimulation(item.Job, nil)
+		if err != nil {
+			return fmt.Errorf("summarizing job '%s' for nesting: %w", item.Job.Name, err)
+		}
+		length := summary.DevelopedLengthMM
+		width := item.Job.Sheet.Width
+
+		// Blank outline plus a label; one outline per part, quantity goes
+		// in the label and the manifest.
+		dxfLine(&dxf, "BLANK", 0, yCursor, length, yCursor)
+		dxfLine(&dxf, "BLANK", length, yCursor, length, yCursor+width)
+		dxfLine(&dxf, "BLANK", length, yCursor+width, 0, yCursor+width)
+		dxfLine(&dxf, "BLANK", 0, yCursor+width, 0, yCursor)
+		dxfText(&dxf, "LABEL", 5, yCursor+width/2, 5,
+			fmt.Sprintf("%s x%d", item.Job.Name, qty))
+		yCursor += width + nestingGapMM
+
+		csv.WriteString(fmt.Sprintf("%s,%d,%.2f,%.2f,%.2f,%s\n",
+			item.Job.Name, qty, length, width, item.Job.Sheet.Thickness, item.Job.Sheet.Material.Name))
+	}
+
+	dxf.WriteString("0\nENDSEC\n0\nEOF\n")
+
+	if err := os.WriteFile(dxfPath, []byte(dxf.String()), 0644); err != nil {
+		return fmt.Errorf("writing nesting DXF: %w", err)
+	}
+	if err := os.WriteFile(csvPath, []byte(csv.String()), 0644); err != nil {
+		return fmt.Errorf("writing nesting manifest: %w", err)
+	}
+	log.Printf("INFO: Exported nesting batch: %d parts to '%s' (manifest '%s').", len(items), dxfPath, csvPath)
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"gioui.org/io/key"
+)
+
+// --- Keyboard bend nudging ---
+// Tuning a program against a drawing means many tiny corrections. With a
+// step selected in the sequence list, the arrow keys nudge it in place:
+// left/right move the position, up/down adjust the target angle, and
+// holding Shift multiplies the increment by ten. Every nudge re-validates
+// the step and re-runs the simulation so the profile tracks the keys.
+
+// NudgeIncrements is the step size applied per arrow-key press.
+type NudgeIncrements struct {
+	PositionMM float64 // Left/right increment.
+	AngleDeg   float64 // Up/down increment.
+}
+
+// nudgeIncrements is the active configuration; shops with coarse drawings
+// bump these up via SetNudgeIncrements.
+var nudgeIncrements = NudgeIncrements{PositionMM: 0.1, AngleDeg: 0.5}
+
+// SetNudgeIncrements configures the per-press step sizes.
+func SetNudgeIncrements(positionMM, angleDeg float64) error {
+	if positionMM <= 0 || angleDeg <= 0 {
+		return fmt.Errorf("nudge increments must be positive (got %.3fmm, %.3f°)", positionMM, angleDeg)
+	}
+	nudgeIncrements = NudgeIncrements{PositionMM: positionMM, AngleDeg: angleDeg}
+	return nil
+}
+
+// GetNudgeIncrements returns the active per-press step sizes.
+func GetNudgeIncrements() NudgeIncrements { return nudgeIncrements }
+
+// selectStep marks a sequence-list row as the nudge target.
+func (ac *AppController) selectStep(idx int) {
+	ac.selectedStepIdx = idx
+	if ac.currentJob != nil && idx >= 0 && idx < len(ac.currentJob.Steps) {
+		ac.updateStatus(fmt.Sprintf("Step %d selected. Arrow keys nudge position/angle (Shift = x10).",
+			ac.currentJob.Steps[idx].SequenceOrder), false)
+	}
+	ac.signalUIUpdate()
+}
+
+// handleNudgeKey applies an arrow-key nudge to the selected step. It
+// reports whether the key was consumed.
+func (ac *AppController) handleNudgeKey(e key.Event) bool {
+	if e.State != key.Press {
+		return false
+	}
+	var dPos, dAngle float64
+	switch e.Name {
+	case key.NameLeftArrow:
+		dPos = -nudgeIncrements.PositionMM
+	case key.NameRightArrow:
+		dPos = nudgeIncrements.PositionMM
+	case key.NameUpArrow:
+		dAngle = nudgeIncrements.AngleDeg
+	case key.NameDownArrow:
+		dAngle = -nudgeIncrements.AngleDeg
+	default:
+		return false
+	}
+	if ac.currentJob == nil || ac.selectedStepIdx < 0 || ac.selectedStepIdx >= len(ac.currentJob.Steps) {
+		return false
+	}
+	if ac.blockInViewerMode() {
+		return true
+	}
+	if e.Modifiers.Contain(key.ModShift) {
+		dPos *= 10
+		dAngle *= 10
+	}
+
+	step := ac.currentJob.Steps[ac.selectedStepIdx]
+	newPos, newAngle := step.Position+dPos, step.TargetAngle+dAngle
+	if err := validateBendStepParams(ac.currentJob.Sheet, newPos, newAngle, step.Radius); err != nil {
+		ac.updateStatus(fmt.Sprintf("Nudge refused: %v", err), true)
+		return true
+	}
+	step.Position, step.TargetAngle = newPos, newAngle
+	if ac.jobController != nil {
+		ac.jobController.InvalidateStepsFrom(ac.selectedStepIdx)
+	}
+	ac.markJobDirty()
+	ac.updateStatus(fmt.Sprintf("Step %d: Pos %.1fmm, Angle %s.", step.SequenceOrder,
+		step.Position, FormatAngle(step.TargetAngle, ac.currentJob.AngleConv)), false)
+	ac.handleExecuteBendProcess()
+	return true
+}
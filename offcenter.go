@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Off-center load check ---
+// Bending far from the ram centerline loads one side frame much harder than
+// the other and tries to tilt the ram. Real controls restrict how far off
+// center a given tonnage may be applied; the simulator flags the same
+// condition so programs transfer to the machine without surprises.
+
+// maxOffCenterRatio is the default fraction of half the bed length a bend
+// may sit away from the centerline before it counts as off-center loading.
+const maxOffCenterRatio = 0.25
+
+// OffCenterAssessment is the result of checking one step for asymmetric
+// loading.
+type OffCenterAssessment struct {
+	OffsetMM float64 // Distance of the bend-line midpoint from the ram centerline.
+	LimitMM  float64 // Allowed offset for this machine and load.
+	Exceeded bool
+	Message  string
+}
+
+// BedLength is the usable bed length of the machine in millimeters. Zero
+// means unknown, in which case off-center checks are skipped.
+func (pb *PressBrake) BedLength() float64 { return pb.bedLengthMM }
+
+// SetBedLength configures the usable bed length.
+func (pb *PressBrake) SetBedLength(mm float64) error {
+	if mm < 0 {
+		return fmt.Errorf("bed length cannot be negative: %.1f", mm)
+	}
+	pb.bedLengthMM = mm
+	return nil
+}
+
+// AssessOffCenterLoad checks whether the sheet, positioned with stationOffsetMM
+// between the sheet center and the ram centerline, loads the ram too far off
+// center. Positive offsets are toward the right side frame.
+func AssessOffCenterLoad(sheet *SheetMetal, stationOffsetMM float64, pb *PressBrake) *OffCenterAssessment {
+	if sheet == nil || pb == nil || pb.bedLengthMM <= 0 {
+		return nil
+	}
+	limit := pb.bedLengthMM / 2 * maxOffCenterRatio
+	assess := &OffCenterAssessment{
+		OffsetMM: math.Abs(stationOffsetMM),
+		LimitMM:  limit,
+	}
+	if assess.OffsetMM > limit {
+		assess.Exceeded = true
+		assess.Message = fmt.Sprintf(
+			"Off-center loading: bend is %.0fmm from the ram centerline (limit %.0fmm). Reposition the station or split the job to avoid ram tilt.",
+			assess.OffsetMM, limit)
+	}
+	return assess
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// --- Operations grouping ---
+// Parts that leave the brake for welding or plating and come back get
+// programmed as one job but run as separate operations ("Op10 pre-bends",
+// "Op20 finish"). Steps carry an operation name; paperwork and cycle
+// times can then be produced per operation instead of per job.
+
+// defaultOperationName groups steps that were never assigned an operation.
+const defaultOperationName = "Op10"
+
+// SetStepOperation assigns steps (by 1-based sequence order) to a named
+// operation.
+func (j *Job) SetStepOperation(opName string, sequenceOrders ...int) error {
+	opName = strings.TrimSpace(opName)
+	if opName == "" {
+		return fmt.Errorf("operation name cannot be empty")
+	}
+	assigned := 0
+	for _, step := range j.Steps {
+		if step == nil {
+			continue
+		}
+		for _, seq := range sequenceOrders {
+			if step.SequenceOrder == seq {
+				step.Operation = opName
+				assigned++
+			}
+		}
+	}
+	if assigned != len(sequenceOrders) {
+		return fmt.Errorf("assigned %d of %d steps to operation '%s'; check sequence numbers", assigned, len(sequenceOrders), opName)
+	}
+	return nil
+}
+
+// OperationNames returns the job's operation names in first-appearance
+// order, substituting the default for unassigned steps.
+func (j *Job) OperationNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, step := range j.Steps {
+		if step == nil {
+			continue
+		}
+		name := step.Operation
+		if name == "" {
+			name = defaultOperationName
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// operationSubJob builds a view of the job containing only the steps of
+// one operation, for per-operation paperwork and estimates.
+func (j *Job) operationSubJob(opName string) *Job {
+	sub := &Job{
+		Name:             fmt.Sprintf("%s [%s]", j.Name, opName),
+		Customer:         j.Customer,
+		OrderID:          j.OrderID,
+		Sheet:            j.Sheet,
+		Overrides:        j.Overrides,
+		ReferenceFlipped: j.ReferenceFlipped,
+	}
+	for _, step := range j.Steps {
+		if step == nil {
+			continue
+		}
+		name := step.Operation
+		if name == "" {
+			name = defaultOperationName
+		}
+		if name == opName {
+			sub.Steps = append(sub.Steps, step)
+		}
+	}
+	return sub
+}
+
+// GenerateOperationSetupSheets writes one setup sheet per operation into
+// dir and returns the written paths, in operation order.
+func GenerateOperationSetupSheets(j *Job, pb *PressBrake, dir string) ([]string, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for operation setup sheets")
+	}
+	var paths []string
+	for _, opName := range j.OperationNames() {
+		path := filepath.Join(dir, fmt.Sprintf("setup_%s_%s.txt",
+			strings.ReplaceAll(j.Name, " ", "_"), strings.ReplaceAll(opName, " ", "_")))
+		if err := GenerateSetupSheet(j.operationSubJob(opName), pb, path); err != nil {
+			return paths, fmt.Errorf("setup sheet for operation '%s': %w", opName, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// OperationCycleSeconds estimates cycle time separately per operation.
+func OperationCycleSeconds(j *Job, model CycleTimeModel) map[string]float64 {
+	out := make(map[string]float64)
+	if j == nil {
+		return out
+	}
+	for _, opName := range j.OperationNames() {
+		out[opName] = EstimateJobCycleSeconds(j.operationSubJob(opName), model)
+	}
+	return out
+}
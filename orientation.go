@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+)
+
+// --- Blank orientation on the stock sheet ---
+// Two forces pull on the nesting layout: bends want their lines across
+// the rolling grain (the forgiving case for tight radii), and the cutter
+// wants as many blanks per stock sheet as will fit. The two often
+// disagree, so the suggestion evaluates both candidate orientations —
+// blank length along the grain or across it — and the caller picks which
+// criterion wins ties the other way.
+
+// Grain runs along the stock sheet's length by convention.
+
+// OrientationPriority selects which criterion decides when the two
+// orientations disagree.
+type OrientationPriority string
+
+const (
+	PrioritizeGrain OrientationPriority = "grain" // Avoid along-grain bends first.
+	PrioritizeYield OrientationPriority = "yield" // Most parts per sheet first.
+)
+
+// StockSheet is the raw sheet blanks are cut from.
+type StockSheet struct {
+	LengthMM float64 // Grain direction.
+	WidthMM  float64
+}
+
+// OrientationCandidate is one evaluated way to lay the blank on the stock.
+type OrientationCandidate struct {
+	RotationDeg     int // 0 = blank length along the grain, 90 = across.
+	GrainViolations int // Steps whose radius is under the along-grain minimum.
+	PartsPerSheet   int
+}
+
+// OrientationSuggestion is the chosen orientation with its reasoning.
+type OrientationSuggestion struct {
+	Chosen   OrientationCandidate
+	Rejected OrientationCandidate
+	Reason   string
+}
+
+// grainViolations counts steps whose radius is below the minimum for the
+// orientation their bend lines would take.
+func grainViolations(j *Job, o BendOrientation) int {
+	min := j.Sheet.GetMinBendRadiusFor(o)
+	count := 0
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		if step.Radius < min {
+			count++
+		}
+	}
+	return count
+}
+
+// partsPerSheet counts how many l x w blanks (plus the nesting gap) fit
+// on the stock in a simple grid.
+func partsPerSheet(stock StockSheet, l, w float64) int {
+	if l <= 0 || w <= 0 || stock.LengthMM <= 0 || stock.WidthMM <= 0 {
+		return 0
+	}
+	cols := int((stock.LengthMM + nestingGapMM) / (l + nestingGapMM))
+	rows := int((stock.WidthMM + nestingGapMM) / (w + nestingGapMM))
+	if cols < 0 || rows < 0 {
+		return 0
+	}
+	return cols * rows
+}
+
+// SuggestBlankOrientation evaluates both orientations for the job's blank
+// on the given stock and returns the one the priority favors.
+func SuggestBlankOrientation(j *Job, stock StockSheet, priority OrientationPriority) (*OrientationSuggestion, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for orientation suggestion")
+	}
+	blank, err := j.FlatPattern()
+	if err != nil {
+		return nil, err
+	}
+
+	// Blank length along the grain: bend lines (parallel to the blank's
+	// width) run across the grain — the forgiving case.
+	along := OrientationCandidate{
+		RotationDeg:     0,
+		GrainViolations: grainViolations(j, BendTransverse),
+		PartsPerSheet:   partsPerSheet(stock, blank, j.Sheet.Width),
+	}
+	// Rotated 90°: bend lines run along the grain.
+	across := OrientationCandidate{
+		RotationDeg:     90,
+		GrainViolations: grainViolations(j, BendLongitudinal),
+		PartsPerSheet:   partsPerSheet(stock, j.Sheet.Width, blank),
+	}
+
+	better := func(a, b OrientationCandidate) bool {
+		if priority == PrioritizeYield {
+			if a.PartsPerSheet != b.PartsPerSheet {
+				return a.PartsPerSheet > b.PartsPerSheet
+			}
+			return a.GrainViolations < b.GrainViolations
+		}
+		if a.GrainViolations != b.GrainViolations {
+			return a.GrainViolations < b.GrainViolations
+		}
+		return a.PartsPerSheet > b.PartsPerSheet
+	}
+
+	sug := &OrientationSuggestion{Chosen: along, Rejected: across}
+	if better(across, along) {
+		sug.Chosen, sug.Rejected = across, along
+	}
+	sug.Reason = fmt.Sprintf("rotation %d°: %d part(s)/sheet, %d grain violation(s) (vs %d/%d at %d°, priority %s)",
+		sug.Chosen.RotationDeg, sug.Chosen.PartsPerSheet, sug.Chosen.GrainViolations,
+		sug.Rejected.PartsPerSheet, sug.Rejected.GrainViolations, sug.Rejected.RotationDeg, priority)
+	return sug, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// --- Severity levels and warning overrides ---
+// Not every validation failure is equal: some describe physically impossible
+// input (hard errors, never bypassable), others are recommendations such as
+// the minimum bend radius (warnings the operator may override). Overrides
+// must carry a recorded justification and are listed on the setup sheet.
+
+// Severity classifies a validation finding.
+type Severity int
+
+const (
+	SeverityError   Severity = iota // Physically impossible; cannot be overridden.
+	SeverityWarning                 // Overridable with a recorded justification.
+)
+
+// String returns the display name of the severity.
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "Warning"
+	}
+	return "Error"
+}
+
+// ValidationIssue is one finding from the validation pipeline, carrying its
+// rule ID and severity so the UI can decide between blocking and confirming.
+type ValidationIssue struct {
+	RuleID   string
+	Severity Severity
+	Message  string
+}
+
+// Rule ID for the recommended minimum bend radius warning.
+const RuleMinBendRadiusWarn = "BEND.RADIUS.MIN"
+
+// RuleOverride records that a user accepted a warning for a specific step,
+// with the justification they gave. Overrides persist with the job and are
+// printed on the setup sheet.
+type RuleOverride struct {
+	RuleID        string
+	StepOrder     int // SequenceOrder of the affected step; 0 for job-level.
+	Message       string
+	Justification string
+	Timestamp     time.Time
+}
+
+// RecordOverride attaches a warning override to the current job. A
+// justification is mandatory; overrides without one are refused.
+func (jc *JobController) RecordOverride(ruleID string, stepOrder int, message, justification string) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to record override on")
+	}
+	if justification == "" {
+		return fmt.Errorf("override of rule '%s' requires a justification", ruleID)
+	}
+	ov := RuleOverride{
+		RuleID:        ruleID,
+		StepOrder:     stepOrder,
+		Message:       message,
+		Justification: justification,
+		Timestamp:     time.Now(),
+	}
+	jc.currentJob.Overrides = append(jc.currentJob.Overrides, ov)
+	log.Printf("INFO: Override recorded on job '%s': rule %s, step %d, justification: %s",
+		jc.currentJob.Name, ruleID, stepOrder, justification)
+	return nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// --- Parametric jobs ---
+// A drawer family differs only in L, W and H; programming each size as
+// its own job is busywork. A parametric job expresses dimensions and
+// bend positions as formulas over named variables and is instantiated
+// with concrete values at simulation time. The expression language is
+// deliberately tiny: numbers, variables, + - * /, and parentheses.
+
+// ParametricStep is one bend step with formula-valued fields.
+type ParametricStep struct {
+	PositionExpr string
+	AngleExpr    string
+	RadiusExpr   string
+	Direction    BendDirection
+}
+
+// ParametricJob is a job template over named variables.
+type ParametricJob struct {
+	Name          string
+	Variables     []string // Declared variable names, e.g. "L", "W", "H".
+	LengthExpr    string
+	WidthExpr     string
+	ThicknessExpr string
+	Material      MaterialDetails
+	Steps         []ParametricStep
+}
+
+// exprParser is a recursive-descent evaluator over one expression string.
+type exprParser struct {
+	input string
+	pos   int
+	vars  map[string]float64
+}
+
+// EvalExpr evaluates a formula with the given variable values.
+func EvalExpr(expr string, vars map[string]float64) (float64, error) {
+	p := &exprParser{input: expr, vars: vars}
+	v, err := p.parseSum()
+	if err != nil {
+		return 0, fmt.Errorf("in %q: %w", expr, err)
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return 0, fmt.Errorf("in %q: unexpected %q at position %d", expr, p.input[p.pos], p.pos)
+	}
+	return v, nil
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *exprParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *exprParser) parseSum() (float64, error) {
+	v, err := p.parseProduct()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '+':
+			p.pos++
+			rhs, err := p.parseProduct()
+			if err != nil {
+				return 0, err
+			}
+			v += rhs
+		case '-':
+			p.pos++
+			rhs, err := p.parseProduct()
+			if err != nil {
+				return 0, err
+			}
+			v -= rhs
+		default:
+			return v, nil
+		}
+	}
+}
+
+func (p *exprParser) parseProduct() (float64, error) {
+	v, err := p.parseAtom()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '*':
+			p.pos++
+			rhs, err := p.parseAtom()
+			if err != nil {
+				return 0, err
+			}
+			v *= rhs
+		case '/':
+			p.pos++
+			rhs, err := p.parseAtom()
+			if err != nil {
+				return 0, err
+			}
+			if rhs == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+			v /= rhs
+		default:
+			return v, nil
+		}
+	}
+}
+
+func (p *exprParser) parseAtom() (float64, error) {
+	c := p.peek()
+	switch {
+	case c == '(':
+		p.pos++
+		v, err := p.parseSum()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ')' {
+			return 0, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return v, nil
+	case c == '-':
+		p.pos++
+		v, err := p.parseAtom()
+		return -v, err
+	case c >= '0' && c <= '9' || c == '.':
+		start := p.pos
+		for p.pos < len(p.input) && (p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		return strconv.ParseFloat(p.input[start:p.pos], 64)
+	case unicode.IsLetter(rune(c)):
+		start := p.pos
+		for p.pos < len(p.input) && (unicode.IsLetter(rune(p.input[p.pos])) || unicode.IsDigit(rune(p.input[p.pos])) || p.input[p.pos] == '_') {
+			p.pos++
+		}
+		name := p.input[start:p.pos]
+		v, ok := p.vars[name]
+		if !ok {
+			return 0, fmt.Errorf("undefined variable '%s'", name)
+		}
+		return v, nil
+	case c == 0:
+		return 0, fmt.Errorf("unexpected end of expression")
+	default:
+		return 0, fmt.Errorf("unexpected character %q", c)
+	}
+}
+
+// Instantiate evaluates the template with concrete variable values and
+// returns a regular job ready to simulate. Every declared variable must
+// be supplied.
+func (pj *ParametricJob) Instantiate(values map[string]float64) (*Job, error) {
+	for _, name := range pj.Variables {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("missing value for variable '%s'", name)
+		}
+	}
+
+	length, err := EvalExpr(pj.LengthExpr, values)
+	if err != nil {
+		return nil, fmt.Errorf("sheet length: %w", err)
+	}
+	width, err := EvalExpr(pj.WidthExpr, values)
+	if err != nil {
+		return nil, fmt.Errorf("sheet width: %w", err)
+	}
+	thickness, err := EvalExpr(pj.ThicknessExpr, values)
+	if err != nil {
+		return nil, fmt.Errorf("sheet thickness: %w", err)
+	}
+
+	var valueDesc []string
+	for _, name := range pj.Variables {
+		valueDesc = append(valueDesc, fmt.Sprintf("%s=%g", name, values[name]))
+	}
+	sheetID := fmt.Sprintf("%s(%s)", pj.Name, strings.Join(valueDesc, ","))
+	sheet, err := NewSheetMetal(sheetID, length, width, thickness, pj.Material)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating sheet: %w", err)
+	}
+
+	job, err := NewJob(sheetID, sheet)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating job: %w", err)
+	}
+	for i, ps := range pj.Steps {
+		pos, err := EvalExpr(ps.PositionExpr, values)
+		if err != nil {
+			return nil, fmt.Errorf("step %d position: %w", i+1, err)
+		}
+		angle, err := EvalExpr(ps.AngleExpr, values)
+		if err != nil {
+			return nil, fmt.Errorf("step %d angle: %w", i+1, err)
+		}
+		radius, err := EvalExpr(ps.RadiusExpr, values)
+		if err != nil {
+			return nil, fmt.Errorf("step %d radius: %w", i+1, err)
+		}
+		if pos <= 0 || pos >= length {
+			return nil, fmt.Errorf("step %d: solved position %.2fmm is outside the %.2fmm sheet", i+1, pos, length)
+		}
+		job.Steps = append(job.Steps, &BendStep{
+			SequenceOrder: i + 1,
+			Position:      pos,
+			TargetAngle:   angle,
+			Radius:        radius,
+			Direction:     ps.Direction,
+		})
+	}
+	return job, nil
+}
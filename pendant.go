@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+)
+
+// --- Stroke animation control and pendant input ---
+// Training mode animates the ram stroke. Besides the keyboard, a USB jog
+// pendant or gamepad can drive it, which feels much closer to standing at
+// a real machine. Device access happens in a small external helper (or any
+// program that can write lines); this side maps its button events onto
+// animation actions, so no HID dependency is pulled into the app.
+
+// PendantAction is one animation control action.
+type PendantAction string
+
+const (
+	PendantJogDown PendantAction = "JogDown" // Move the ram down one increment.
+	PendantJogUp   PendantAction = "JogUp"   // Move the ram up one increment.
+	PendantConfirm PendantAction = "Confirm" // Confirm the current step / advance.
+	PendantStop    PendantAction = "Stop"    // Halt the animation immediately.
+)
+
+// PendantMapping maps raw device button names to actions. The defaults fit
+// a standard gamepad reported through evdev-style names.
+type PendantMapping map[string]PendantAction
+
+// DefaultPendantMapping returns the standard gamepad button assignment.
+func DefaultPendantMapping() PendantMapping {
+	return PendantMapping{
+		"BTN_SOUTH":  PendantJogDown, // A / cross.
+		"BTN_NORTH":  PendantJogUp,   // Y / triangle.
+		"BTN_EAST":   PendantStop,    // B / circle.
+		"BTN_START":  PendantConfirm,
+		"JOG_MINUS":  PendantJogDown, // Jog pendant wheel.
+		"JOG_PLUS":   PendantJogUp,
+		"JOG_ENABLE": PendantConfirm,
+	}
+}
+
+// StrokeAnimation is the shared state of the stroke animation: ram position
+// runs from 0 (top) to 1 (bottom dead center). Safe for concurrent use, as
+// pendant input arrives from a reader goroutine.
+type StrokeAnimation struct {
+	mu        sync.Mutex
+	RamPct    float64 // 0 = open, 1 = fully closed.
+	StepIndex int     // Step currently being animated.
+	Running   bool
+
+	// Pinch-point hold (see gaugeopts.go): the ram stops at PinchHoldPct
+	// until a confirm releases it. 0 = no hold armed for this step.
+	PinchHoldPct  float64
+	PinchReleased bool
+}
+
+// jogIncrement is the ram travel per jog event, as a fraction of the stroke.
+const jogIncrement = 0.02
+
+// Apply performs one pendant action on the animation state and reports
+// whether the state changed (so the UI knows to redraw).
+func (sa *StrokeAnimation) Apply(action PendantAction) bool {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	switch action {
+	case PendantJogDown:
+		if sa.RamPct >= 1 {
+			return false
+		}
+		if sa.PinchHoldPct > 0 && !sa.PinchReleased && sa.RamPct >= sa.PinchHoldPct {
+			return false // Held at the pinch point; confirm to continue.
+		}
+		sa.RamPct += jogIncrement
+		if sa.PinchHoldPct > 0 && !sa.PinchReleased && sa.RamPct > sa.PinchHoldPct {
+			sa.RamPct = sa.PinchHoldPct
+		}
+		if sa.RamPct > 1 {
+			sa.RamPct = 1
+		}
+	case PendantJogUp:
+		if sa.RamPct <= 0 {
+			return false
+		}
+		sa.RamPct -= jogIncrement
+		if sa.RamPct < 0 {
+			sa.RamPct = 0
+		}
+	case PendantConfirm:
+		if sa.PinchHoldPct > 0 && !sa.PinchReleased && sa.RamPct >= sa.PinchHoldPct {
+			sa.PinchReleased = true // Sheet confirmed; stroke may continue.
+			return true
+		}
+		sa.StepIndex++
+		sa.RamPct = 0
+		sa.PinchHoldPct = 0
+		sa.PinchReleased = false
+	case PendantStop:
+		sa.Running = false
+	default:
+		return false
+	}
+	return true
+}
+
+// Snapshot returns a copy of the current animation state for drawing.
+func (sa *StrokeAnimation) Snapshot() (ramPct float64, stepIndex int, running bool) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	return sa.RamPct, sa.StepIndex, sa.Running
+}
+
+// ReadPendantEvents consumes button-name lines from r (one event per line),
+// maps them through the mapping, and applies them to the animation. onChange
+// is called after every state change (typically to invalidate the window).
+// It returns when the reader is exhausted or fails.
+func ReadPendantEvents(r io.Reader, mapping PendantMapping, anim *StrokeAnimation, onChange func()) error {
+	if anim == nil {
+		return fmt.Errorf("animation state is nil for pendant input")
+	}
+	if mapping == nil {
+		mapping = DefaultPendantMapping()
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		button := scanner.Text()
+		action, ok := mapping[button]
+		if !ok {
+			continue // Unmapped buttons are ignored, not errors.
+		}
+		if anim.Apply(action) && onChange != nil {
+			onChange()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading pendant events: %w", err)
+	}
+	log.Println("INFO: Pendant event stream ended.")
+	return nil
+}
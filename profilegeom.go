@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+)
+
+// --- Folded cross-section geometry ---
+// The profile views drew a rectangle no matter what was bent. This engine
+// walks the recorded bend list and produces the true folded cross-section
+// of the sheet mid-line: straight legs shortened by the tangent setback of
+// each bend, with a real arc at the achievable radius between them. The
+// SVG exporter and the in-app panel both draw from this one geometry.
+
+// ProfileElementKind discriminates cross-section elements.
+type ProfileElementKind int
+
+const (
+	ProfileLine ProfileElementKind = iota
+	ProfileArc
+)
+
+// pt2 is a point in cross-section space (X along the blank, Y up, mm).
+type pt2 struct{ X, Y float64 }
+
+// ProfileElement is one leg or bend arc of the folded cross-section.
+type ProfileElement struct {
+	Kind     ProfileElementKind
+	From, To pt2
+	Center   pt2     // Arc only.
+	RadiusMM float64 // Arc only: mid-line radius.
+	SweepRad float64 // Arc only: signed, positive = counter-clockwise.
+}
+
+// CrossSection is the folded mid-line of the sheet.
+type CrossSection struct {
+	Elements    []ProfileElement
+	ThicknessMM float64
+}
+
+// profileArcSegments is the flattening resolution for arcs when a renderer
+// needs a polyline.
+const profileArcSegments = 16
+
+// BuildCrossSection walks the sheet's recorded bends into the folded
+// cross-section. Bends whose tangent setbacks would overlap the adjacent
+// legs degrade to sharp corners rather than producing crossing geometry.
+func BuildCrossSection(sheet *SheetMetal) (*CrossSection, error) {
+	if sheet == nil {
+		return nil, fmt.Errorf("sheet is nil for cross-section geometry")
+	}
+	t := sheet.Thickness
+	bends := make([]BendStep, 0, len(sheet.CurrentBends))
+	for _, b := range sheet.CurrentBends {
+		if b.Position > 0 && b.Position < sheet.OriginalLength {
+			bends = append(bends, b)
+		}
+	}
+	sort.Slice(bends, func(a, b int) bool { return bends[a].Position < bends[b].Position })
+
+	cs := &CrossSection{ThicknessMM: t}
+	heading := 0.0
+	pos := pt2{}
+	prev := 0.0
+	dir := func(ang float64) pt2 { return pt2{math.Cos(ang), math.Sin(ang)} }
+	add := func(p pt2, d pt2, dist float64) pt2 { return pt2{p.X + d.X*dist, p.Y + d.Y*dist} }
+
+	for i, b := range bends {
+		swept := (180.0 - b.TargetAngle) * math.Pi / 180.0
+		if b.Direction == BendDirectionDown {
+			swept = -swept
+		}
+		// Mid-line radius: achievable inner radius plus half the thickness.
+		r := EffectiveInnerRadius(b.Radius, t) + t/2
+		tangent := r * math.Tan(math.Abs(swept)/2)
+
+		legBefore := b.Position - prev
+		legAfter := sheet.OriginalLength - b.Position
+		if i+1 < len(bends) {
+			legAfter = bends[i+1].Position - b.Position
+		}
+		if tangent > legBefore || tangent > legAfter || math.Abs(swept) >= math.Pi {
+			// No room (or a full fold): sharp corner at the bend line.
+			corner := add(pos, dir(heading), legBefore)
+			cs.Elements = append(cs.Elements, ProfileElement{Kind: ProfileLine, From: pos, To: corner})
+			pos = corner
+			heading += swept
+			prev = b.Position
+			continue
+		}
+
+		arcStart := add(pos, dir(heading), legBefore-tangent)
+		cs.Elements = append(cs.Elements, ProfileElement{Kind: ProfileLine, From: pos, To: arcStart})
+		side := 1.0
+		if swept < 0 {
+			side = -1.0
+		}
+		center := add(arcStart, dir(heading+side*math.Pi/2), r)
+		arcEnd := add(add(pos, dir(heading), legBefore), dir(heading+swept), tangent)
+		cs.Elements = append(cs.Elements, ProfileElement{
+			Kind: ProfileArc, From: arcStart, To: arcEnd,
+			Center: center, RadiusMM: r, SweepRad: swept,
+		})
+		pos = arcEnd
+		heading += swept
+		prev = b.Position + tangent
+	}
+	end := add(pos, dir(heading), sheet.OriginalLength-prev)
+	cs.Elements = append(cs.Elements, ProfileElement{Kind: ProfileLine, From: pos, To: end})
+	return cs, nil
+}
+
+// FlattenedPolyline returns the cross-section as a single polyline with
+// arcs subdivided, for renderers without arc primitives.
+func (cs *CrossSection) FlattenedPolyline() []pt2 {
+	if len(cs.Elements) == 0 {
+		return nil
+	}
+	pts := []pt2{cs.Elements[0].From}
+	for _, el := range cs.Elements {
+		if el.Kind == ProfileLine {
+			pts = append(pts, el.To)
+			continue
+		}
+		start := math.Atan2(el.From.Y-el.Center.Y, el.From.X-el.Center.X)
+		for s := 1; s <= profileArcSegments; s++ {
+			ang := start + el.SweepRad*float64(s)/profileArcSegments
+			pts = append(pts, pt2{
+				el.Center.X + el.RadiusMM*math.Cos(ang),
+				el.Center.Y + el.RadiusMM*math.Sin(ang),
+			})
+		}
+	}
+	return pts
+}
+
+// Bounds returns the min/max corners of the cross-section.
+func (cs *CrossSection) Bounds() (min, max pt2) {
+	pts := cs.FlattenedPolyline()
+	if len(pts) == 0 {
+		return pt2{}, pt2{}
+	}
+	min, max = pts[0], pts[0]
+	for _, p := range pts {
+		min.X = math.Min(min.X, p.X)
+		min.Y = math.Min(min.Y, p.Y)
+		max.X = math.Max(max.X, p.X)
+		max.Y = math.Max(max.Y, p.Y)
+	}
+	return min, max
+}
+
+// SVGPathData renders the cross-section as an SVG path in part
+// coordinates (Y up; pair with a scale(s,-s) transform).
+func (cs *CrossSection) SVGPathData() string {
+	if len(cs.Elements) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("M %.3f %.3f", cs.Elements[0].From.X, cs.Elements[0].From.Y))
+	for _, el := range cs.Elements {
+		if el.Kind == ProfileLine {
+			sb.WriteString(fmt.Sprintf(" L %.3f %.3f", el.To.X, el.To.Y))
+			continue
+		}
+		largeArc := 0
+		if math.Abs(el.SweepRad) > math.Pi {
+			largeArc = 1
+		}
+		// Positive (counter-clockwise, Y up) sweeps flip to SVG flag 1
+		// under the Y-mirroring transform.
+		sweepFlag := 0
+		if el.SweepRad > 0 {
+			sweepFlag = 1
+		}
+		sb.WriteString(fmt.Sprintf(" A %.3f %.3f 0 %d %d %.3f %.3f",
+			el.RadiusMM, el.RadiusMM, largeArc, sweepFlag, el.To.X, el.To.Y))
+	}
+	return sb.String()
+}
+
+// layoutCrossSectionView draws the folded cross-section in the profile
+// panel with Gio path ops, fitted to the available area.
+func (ac *AppController) layoutCrossSectionView(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Max
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil {
+		return layout.Dimensions{Size: size}
+	}
+	cs, err := BuildCrossSection(ac.currentJob.Sheet)
+	if err != nil {
+		return layout.Dimensions{Size: size}
+	}
+	pts := cs.FlattenedPolyline()
+	if len(pts) < 2 {
+		return layout.Dimensions{Size: size}
+	}
+	min, max := cs.Bounds()
+	spanX := max.X - min.X + 1e-6
+	spanY := max.Y - min.Y + 1e-6
+	scale := math.Min(
+		(float64(size.X)-2*view3DMarginPx)/spanX,
+		(float64(size.Y)-2*view3DMarginPx)/spanY,
+	)
+	toScreen := func(p pt2) f32.Point {
+		return f32.Pt(
+			float32((p.X-(min.X+max.X)/2)*scale)+float32(size.X)/2,
+			float32(-(p.Y-(min.Y+max.Y)/2)*scale)+float32(size.Y)/2,
+		)
+	}
+	screen := make([]f32.Point, len(pts))
+	for i, p := range pts {
+		screen[i] = toScreen(p)
+	}
+	width := float32(cs.ThicknessMM * scale)
+	if width < 1.5 {
+		width = 1.5
+	}
+	strokePolyline(gtx, screen, color.NRGBA{R: 0x26, G: 0x4A, B: 0x73, A: 0xFF}, width)
+	return layout.Dimensions{Size: size}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// --- Machine program diff on re-export ---
+// A program that reaches the machine twice should be the same program
+// twice — or someone should have said yes to the difference. The bridge
+// line format is regenerated through one builder here; on re-export for
+// a job that went out before, the new program is diffed against what was
+// last sent and the operator confirms the changes before they leave.
+
+// BendProgramLines builds the job's program in the bridge wire format
+// (HELLO/BEND/BYE). This is the single source the streamer and the diff
+// both use, so what is compared is exactly what is sent.
+func BendProgramLines(j *Job) ([]string, error) {
+	if j == nil {
+		return nil, fmt.Errorf("job is nil for program generation")
+	}
+	lines := []string{fmt.Sprintf("HELLO %s", strings.ReplaceAll(j.Name, " ", "_"))}
+	if j.Corrections.Active() {
+		// Announce export-time corrections so the receiving side (and the
+		// diff) can see tuned output is in play.
+		lines = append(lines, fmt.Sprintf("CORR %s %s",
+			FormatProgramPosition(j.Corrections.PositionOffsetMM), FormatProgramAngle(j.Corrections.AngleOffsetDeg)))
+	}
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue // Disabled steps never reach the machine.
+		}
+		dir := "UP"
+		if step.Direction == BendDirectionDown {
+			dir = "DOWN"
+		}
+		lines = append(lines, fmt.Sprintf("BEND %d %s %s %s %s%s", step.SequenceOrder,
+			FormatProgramPosition(correctedPosition(j, step.Position)), FormatProgramAngle(correctedAngle(j, step.TargetAngle)), FormatProgramPosition(step.Radius), dir,
+			stepOptionTokens(step)))
+	}
+	lines = append(lines, "BYE")
+	return lines, nil
+}
+
+// programRegistry remembers the last exported program per job name.
+var programRegistry = struct {
+	mu   sync.Mutex
+	last map[string][]string
+}{last: make(map[string][]string)}
+
+// RecordProgramExport stores the program as the job's last export.
+func RecordProgramExport(jobName string, lines []string) {
+	programRegistry.mu.Lock()
+	programRegistry.last[jobName] = append([]string(nil), lines...)
+	programRegistry.mu.Unlock()
+}
+
+// LastProgramExport returns the job's previously exported program, if any.
+func LastProgramExport(jobName string) ([]string, bool) {
+	programRegistry.mu.Lock()
+	defer programRegistry.mu.Unlock()
+	lines, ok := programRegistry.last[jobName]
+	return lines, ok
+}
+
+// DiffPrograms returns a line diff between two programs: unchanged lines
+// prefixed with two spaces, removals with "- ", additions with "+ ".
+// An empty result means the programs are identical.
+func DiffPrograms(oldLines, newLines []string) []string {
+	// Longest-common-subsequence table; programs are short.
+	m, n := len(oldLines), len(newLines)
+	lcs := make([][]int, m+1)
+	for i := range lcs {
+		lcs[i] = make([]int, n+1)
+	}
+	for i := m - 1; i >= 0; i-- {
+		for k := n - 1; k >= 0; k-- {
+			if oldLines[i] == newLines[k] {
+				lcs[i][k] = lcs[i+1][k+1] + 1
+			} else if lcs[i+1][k] >= lcs[i][k+1] {
+				lcs[i][k] = lcs[i+1][k]
+			} else {
+				lcs[i][k] = lcs[i][k+1]
+			}
+		}
+	}
+	var out []string
+	changed := false
+	i, k := 0, 0
+	for i < m && k < n {
+		switch {
+		case oldLines[i] == newLines[k]:
+			out = append(out, "  "+oldLines[i])
+			i++
+			k++
+		case lcs[i+1][k] >= lcs[i][k+1]:
+			out = append(out, "- "+oldLines[i])
+			changed = true
+			i++
+		default:
+			out = append(out, "+ "+newLines[k])
+			changed = true
+			k++
+		}
+	}
+	for ; i < m; i++ {
+		out = append(out, "- "+oldLines[i])
+		changed = true
+	}
+	for ; k < n; k++ {
+		out = append(out, "+ "+newLines[k])
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return out
+}
+
+// confirmProgramExport gates a program export behind a diff confirmation
+// when the job was exported before and the program changed. proceed runs
+// once the export is cleared (immediately for first-time or identical
+// exports) and the new program is recorded as the last export.
+func (ac *AppController) confirmProgramExport(j *Job, proceed func()) {
+	lines, err := BendProgramLines(j)
+	if err != nil {
+		ac.updateStatus(fmt.Sprintf("Program generation failed: %v", err), true)
+		return
+	}
+	clear := func() {
+		RecordProgramExport(j.Name, lines)
+		proceed()
+	}
+	prev, exported := LastProgramExport(j.Name)
+	if !exported {
+		clear()
+		return
+	}
+	diff := DiffPrograms(prev, lines)
+	if diff == nil {
+		clear()
+		return
+	}
+	log.Printf("INFO: Program for job '%s' changed since last export (%d diff line(s)).", j.Name, len(diff))
+	ac.showConfirmDialog("Program Changed",
+		fmt.Sprintf("The program for '%s' differs from the last export:\n\n%s\n\nExport anyway?", j.Name, strings.Join(diff, "\n")),
+		clear, func() { ac.updateStatus("Program export cancelled.", false) })
+}
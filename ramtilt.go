@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Y1/Y2 ram tilt simulation ---
+// Machines with independent Y1/Y2 cylinders can run the ram deliberately
+// tilted, driving the punch deeper at one end than the other. In air
+// bending the resulting penetration difference produces a varying angle
+// along the bend line — the basis of conical bending — so the simulator
+// models the two Y axes explicitly instead of a single ram depth.
+
+// maxRamTiltMMPerM is the default tilt limit: millimeters of Y1/Y2
+// difference per meter of bend length. Typical hydraulic machines allow
+// around 10 mm/m before the guides bind.
+const maxRamTiltMMPerM = 10.0
+
+// RamTiltState is the commanded position of the two Y cylinders for one
+// stroke. Depths are punch penetration past the pinch point in mm.
+type RamTiltState struct {
+	Y1DepthMM float64 // Penetration at the left end of the bend.
+	Y2DepthMM float64 // Penetration at the right end.
+}
+
+// TiltMM returns the Y2-Y1 difference (positive = right side deeper).
+func (rt RamTiltState) TiltMM() float64 { return rt.Y2DepthMM - rt.Y1DepthMM }
+
+// DepthAt interpolates the penetration at a fraction (0=left, 1=right)
+// along the bend length.
+func (rt RamTiltState) DepthAt(frac float64) float64 {
+	if frac < 0 {
+		frac = 0
+	}
+	if frac > 1 {
+		frac = 1
+	}
+	return rt.Y1DepthMM + (rt.Y2DepthMM-rt.Y1DepthMM)*frac
+}
+
+// CheckRamTilt validates a tilt command against the machine limit for a
+// bend of the given length.
+func CheckRamTilt(rt RamTiltState, bendLengthMM float64) error {
+	if bendLengthMM <= 0 {
+		return fmt.Errorf("bend length must be positive for tilt check: %.2fmm", bendLengthMM)
+	}
+	tiltPerM := math.Abs(rt.TiltMM()) / (bendLengthMM / 1000.0)
+	if tiltPerM > maxRamTiltMMPerM {
+		return fmt.Errorf("ram tilt %.2fmm over %.0fmm bend (%.1fmm/m) exceeds machine limit of %.1fmm/m",
+			rt.TiltMM(), bendLengthMM, tiltPerM, maxRamTiltMMPerM)
+	}
+	return nil
+}
+
+// penetrationForAngle estimates the punch penetration needed to air-bend
+// to the given internal angle over die opening vDie. Derived from the
+// V-die geometry: deeper penetration closes the angle further.
+func penetrationForAngle(angleDeg, vDieMM float64) float64 {
+	half := (180.0 - angleDeg) / 2.0 * math.Pi / 180.0
+	return vDieMM / 2.0 * math.Tan(half)
+}
+
+// angleForPenetration is the inverse of penetrationForAngle.
+func angleForPenetration(depthMM, vDieMM float64) float64 {
+	if vDieMM <= 0 {
+		return 180.0
+	}
+	half := math.Atan(2.0 * depthMM / vDieMM)
+	return 180.0 - 2.0*half*180.0/math.Pi
+}
+
+// TiltForAngles returns the ram tilt state that produces angleLeft at the
+// left end and angleRight at the right end of the bend with the given die.
+func TiltForAngles(angleLeft, angleRight float64, die *Die) (RamTiltState, error) {
+	if die == nil || die.VOpening <= 0 {
+		return RamTiltState{}, fmt.Errorf("die with a valid V opening is required for tilt calculation")
+	}
+	return RamTiltState{
+		Y1DepthMM: penetrationForAngle(angleLeft, die.VOpening),
+		Y2DepthMM: penetrationForAngle(angleRight, die.VOpening),
+	}, nil
+}
+
+// AnglesAlongBend samples the resulting internal angle at n evenly spaced
+// points along the bend for a tilted stroke, left to right. Useful for the
+// visualization and for export sanity checks.
+func AnglesAlongBend(rt RamTiltState, die *Die, n int) ([]float64, error) {
+	if die == nil || die.VOpening <= 0 {
+		return nil, fmt.Errorf("die with a valid V opening is required for angle sampling")
+	}
+	if n < 2 {
+		n = 2
+	}
+	angles := make([]float64, n)
+	for i := 0; i < n; i++ {
+		frac := float64(i) / float64(n-1)
+		angles[i] = angleForPenetration(rt.DepthAt(frac), die.VOpening)
+	}
+	return angles, nil
+}
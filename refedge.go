@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// --- Reference edge indicator ---
+// Every Position value is measured from the sheet's reference edge, and
+// programs go wrong when operator and programmer assume different edges.
+// The views therefore mark the reference edge explicitly, and a job can
+// flip it — flipping re-measures all step positions from the opposite
+// edge so the part itself is unchanged.
+
+// FlipReferenceEdge switches the job's reference edge to the opposite end
+// of the sheet, converting every step position to the new datum.
+func (j *Job) FlipReferenceEdge() error {
+	if j.Sheet == nil {
+		return fmt.Errorf("job has no sheet; cannot flip reference edge")
+	}
+	for _, step := range j.Steps {
+		if step != nil {
+			step.Position = j.Sheet.OriginalLength - step.Position
+		}
+	}
+	j.ReferenceFlipped = !j.ReferenceFlipped
+	log.Printf("INFO: Job '%s': reference edge flipped (%d positions re-measured).", j.Name, len(j.Steps))
+	return nil
+}
+
+// svgReferenceEdgeMarker returns an SVG fragment marking the reference
+// edge of the drawn sheet: a filled triangle at the datum edge and an
+// arrow showing the positive Position direction. The sheet rectangle
+// starts at x=20 with the given on-screen length and vertical extents.
+func svgReferenceEdgeMarker(flipped bool, sheetLength, yTop, yBottom float64) string {
+	rp := GetExportRenderProfile()
+	edgeX := 20.0
+	dir := 1.0
+	if flipped {
+		edgeX = 20.0 + sheetLength
+		dir = -1.0
+	}
+
+	var sb strings.Builder
+	// Datum triangle pointing at the reference edge.
+	sb.WriteString(fmt.Sprintf("  <polygon points=\"%.1f,%.1f %.1f,%.1f %.1f,%.1f\" fill=\"%s\" />\n",
+		edgeX, yTop-2, edgeX-4*dir, yTop-10, edgeX+4*dir, yTop-10, rp.OutlineColor))
+	// Arrow along the top edge in the positive Position direction.
+	arrowY := yTop - 6
+	sb.WriteString(fmt.Sprintf("  <line x1=\"%.1f\" y1=\"%.1f\" x2=\"%.1f\" y2=\"%.1f\" stroke=\"%s\" stroke-width=\"%.1f\" />\n",
+		edgeX+8*dir, arrowY, edgeX+38*dir, arrowY, rp.OutlineColor, rp.DetailWeight))
+	sb.WriteString(fmt.Sprintf("  <polygon points=\"%.1f,%.1f %.1f,%.1f %.1f,%.1f\" fill=\"%s\" />\n",
+		edgeX+44*dir, arrowY, edgeX+38*dir, arrowY-3, edgeX+38*dir, arrowY+3, rp.OutlineColor))
+	textX := edgeX + 8*dir
+	if flipped {
+		textX = edgeX - 44
+	}
+	sb.WriteString(fmt.Sprintf("  <text x=\"%.1f\" y=\"%.1f\" class=\"info-text\">REF +</text>\n",
+		textX, yBottom+12))
+	return sb.String()
+}
@@ -0,0 +1,58 @@
+package main
+
+import "log"
+
+// --- Export render profiles ---
+// On-screen colors look terrible from a laser printer and are useless on a
+// pen plotter. Exports therefore render through a profile: the default
+// mirrors the screen scheme, while the print profile is monochrome and
+// relies on line weight instead of color to distinguish features.
+
+// ExportRenderProfile controls how exported documents (SVG/PDF/DXF) are drawn.
+type ExportRenderProfile struct {
+	Name          string
+	Monochrome    bool
+	Background    string  // SVG/CSS color for the document background.
+	SheetFill     string  // Fill for the sheet body.
+	OutlineColor  string  // Stroke color for outlines.
+	TextColor     string  // Color for labels and info text.
+	OutlineWeight float64 // Stroke width for part outlines.
+	DetailWeight  float64 // Stroke width for bend lines and annotations.
+}
+
+// ScreenRenderProfile mirrors the in-app colors (the previous behavior).
+var ScreenRenderProfile = ExportRenderProfile{
+	Name:          "Screen",
+	Background:    "#f8f9fa",
+	SheetFill:     "#e9ecef",
+	OutlineColor:  "#adb5bd",
+	TextColor:     "#495057",
+	OutlineWeight: 0.5,
+	DetailWeight:  0.5,
+}
+
+// PrintRenderProfile is the high-contrast monochrome scheme for laser
+// printing and pen plotting: white background, black lines, heavier
+// outlines than detail lines.
+var PrintRenderProfile = ExportRenderProfile{
+	Name:          "Print",
+	Monochrome:    true,
+	Background:    "#ffffff",
+	SheetFill:     "none",
+	OutlineColor:  "#000000",
+	TextColor:     "#000000",
+	OutlineWeight: 1.2,
+	DetailWeight:  0.4,
+}
+
+// activeExportProfile is the profile used by the exporters.
+var activeExportProfile = ScreenRenderProfile
+
+// SetExportRenderProfile selects the profile used for subsequent exports.
+func SetExportRenderProfile(p ExportRenderProfile) {
+	activeExportProfile = p
+	log.Printf("INFO: Export render profile set to '%s'.", p.Name)
+}
+
+// GetExportRenderProfile returns the profile used for exports.
+func GetExportRenderProfile() ExportRenderProfile { return activeExportProfile }
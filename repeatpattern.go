@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Repeated bend patterns ---
+// Bump-formed arcs, screw flights and stringer plates repeat one bend
+// dozens of times at a fixed pitch. Entering them by hand is tedious and
+// error-prone, so a pattern spec expands into the step list in one go.
+
+// RepeatPattern describes a run of identical bends at a pitch.
+type RepeatPattern struct {
+	StartMM   float64 // Position of the first bend.
+	PitchMM   float64 // Spacing between consecutive bends.
+	Count     int     // Number of bends.
+	AngleDeg  float64 // Internal angle of every bend.
+	RadiusMM  float64 // Inner radius of every bend.
+	Direction BendDirection
+}
+
+// AddRepeatPatternToCurrentJob expands the pattern and appends the steps
+// to the active job, validating that every bend lands on the sheet.
+func (jc *JobController) AddRepeatPatternToCurrentJob(p RepeatPattern) ([]*BendStep, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job selected")
+	}
+	if jc.currentJob.Sheet == nil {
+		return nil, fmt.Errorf("current job has no sheet defined")
+	}
+	if p.Count < 1 {
+		return nil, fmt.Errorf("pattern count must be at least 1, got %d", p.Count)
+	}
+	if p.Count > maxPracticalBumpHits {
+		return nil, fmt.Errorf("pattern of %d bends exceeds the practical limit of %d", p.Count, maxPracticalBumpHits)
+	}
+	if p.PitchMM <= 0 && p.Count > 1 {
+		return nil, fmt.Errorf("pattern pitch must be positive, got %.2fmm", p.PitchMM)
+	}
+
+	sheet := jc.currentJob.Sheet
+	lastPos := p.StartMM + p.PitchMM*float64(p.Count-1)
+	if p.StartMM <= 0 || lastPos >= sheet.OriginalLength {
+		return nil, fmt.Errorf("pattern spans %.1f-%.1fmm, outside sheet length (0-%.1fmm)", p.StartMM, lastPos, sheet.OriginalLength)
+	}
+
+	firstIdx := len(jc.currentJob.Steps)
+	added := make([]*BendStep, 0, p.Count)
+	for i := 0; i < p.Count; i++ {
+		step := &BendStep{
+			SequenceOrder: firstIdx + i + 1,
+			Position:      p.StartMM + p.PitchMM*float64(i),
+			TargetAngle:   p.AngleDeg,
+			Radius:        p.RadiusMM,
+			Direction:     p.Direction,
+		}
+		if err := validateBendStepParams(sheet, step.Position, step.TargetAngle, step.Radius); err != nil {
+			return nil, fmt.Errorf("pattern bend %d: %w", i+1, err)
+		}
+		added = append(added, step)
+	}
+
+	jc.currentJob.Steps = append(jc.currentJob.Steps, added...)
+	jc.InvalidateStepsFrom(firstIdx)
+	log.Printf("INFO: Added repeat pattern to job '%s': %d bends from %.1fmm at %.1fmm pitch.",
+		jc.currentJob.Name, p.Count, p.StartMM, p.PitchMM)
+	return added, nil
+}
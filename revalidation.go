@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// --- Incremental revalidation ---
+// Editing one bend step in a long program should not force a full re-check of
+// every other step. Validation results are cached per step; a change at index
+// i only invalidates the results for i and everything downstream of it.
+
+// StepValidationResult holds the outcome of validating a single bend step.
+type StepValidationResult struct {
+	StepIndex int      // 0-based index into Job.Steps.
+	Valid     bool
+	Messages  []string // Human-readable problems found for this step.
+}
+
+// validationCache stores per-step validation results so that editing one step
+// only forces recomputation of that step and the steps downstream of it.
+type validationCache struct {
+	results   []StepValidationResult
+	validUpTo int // Number of leading entries in results that are still current.
+}
+
+// invalidateFrom marks the cached result for step index idx and everything
+// after it as stale. A negative idx invalidates the whole cache.
+func (vc *validationCache) invalidateFrom(idx int) {
+	if idx < 0 {
+		idx = 0
+	}
+	if idx < vc.validUpTo {
+		vc.validUpTo = idx
+	}
+}
+
+// reset drops all cached results (e.g., after clearing the bend sequence).
+func (vc *validationCache) reset() {
+	vc.results = vc.results[:0]
+	vc.validUpTo = 0
+}
+
+// validateBendStepParams checks a single step's parameters against the sheet.
+// It is shared by AddBendStepToCurrentJob (validating new input) and the
+// incremental revalidation pass (re-checking existing steps after an edit).
+// All limits are evaluated through the active rule set so messages carry
+// their rule IDs.
+func validateBendStepParams(sheet *SheetMetal, pos, angle, radius float64) error {
+	if sheet == nil {
+		return fmt.Errorf("no sheet defined for validation")
+	}
+	rules := GetActiveRuleSet()
+	if pos <= 0 || pos >= sheet.OriginalLength {
+		return fmt.Errorf("[%s] bend position (%.2fmm) is outside sheet length (0-%.2fmm)", RuleBendPosition, pos, sheet.OriginalLength)
+	}
+	if err := rules.Check(RuleBendRadius, radius); err != nil {
+		return err
+	}
+	if err := rules.Check(RuleBendAngle, angle); err != nil {
+		return err
+	}
+	return nil
+}
+
+// InvalidateStepsFrom marks the validation cache stale from the given step
+// index onward. Call this whenever a step is edited, inserted or removed.
+func (jc *JobController) InvalidateStepsFrom(idx int) {
+	jc.valCache.invalidateFrom(idx)
+}
+
+// RevalidateCurrentJob returns validation results for every step of the
+// current job, recomputing only the steps whose cached results are stale.
+func (jc *JobController) RevalidateCurrentJob() ([]StepValidationResult, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job to revalidate")
+	}
+	if jc.currentJob.Sheet == nil {
+		return nil, fmt.Errorf("current job has no sheet defined")
+	}
+
+	steps := jc.currentJob.Steps
+	if jc.valCache.validUpTo > len(steps) {
+		jc.valCache.validUpTo = len(steps)
+	}
+	// Keep the still-valid prefix, recompute the rest.
+	jc.valCache.results = jc.valCache.results[:jc.valCache.validUpTo]
+
+	recomputed := 0
+	for i := jc.valCache.validUpTo; i < len(steps); i++ {
+		step := steps[i]
+		res := StepValidationResult{StepIndex: i, Valid: true}
+		if step == nil {
+			res.Valid = false
+			res.Messages = append(res.Messages, "step data is nil")
+		} else if err := validateBendStepParams(jc.currentJob.Sheet, step.Position, step.TargetAngle, step.Radius); err != nil {
+			res.Valid = false
+			res.Messages = append(res.Messages, err.Error())
+		}
+		jc.valCache.results = append(jc.valCache.results, res)
+		recomputed++
+	}
+	jc.valCache.validUpTo = len(steps)
+
+	if recomputed > 0 {
+		log.Printf("INFO: Revalidated job '%s': %d step(s) recomputed, %d served from cache.",
+			jc.currentJob.Name, recomputed, len(steps)-recomputed)
+	}
+	return jc.valCache.results, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+)
+
+// --- Bump bending vs plate rolling helper ---
+// Very large radii can be faked on the brake by bump bending (many shallow
+// hits) or sent to a plate roll. The helper works out how many bumps keep
+// the faceting within tolerance, estimates the time, and recommends the
+// roll when bumping becomes impractical.
+
+// Defaults for the helper; both are intended to be tuned per shop.
+var (
+	defaultFacetToleranceMM = 0.5  // Max allowed deviation of a facet from the true arc.
+	secondsPerBumpHit       = 12.0 // Gauge move + stroke per bump hit.
+	maxPracticalBumpHits    = 120  // Above this, bumping is deemed impractical.
+)
+
+// RollingRecommendation documents the helper's verdict for a large radius.
+type RollingRecommendation struct {
+	UseBumpBending  bool    // True: bump on the brake; false: send to a roll.
+	BumpHits        int     // Number of hits needed (when bumping).
+	FacetDeviation  float64 // Predicted facet deviation from the true arc (mm).
+	EstimatedMinute float64 // Estimated bumping time in minutes.
+	Reason          string  // Human-readable justification.
+}
+
+// EvaluateLargeRadius decides between bump bending and rolling for an arc of
+// the given radius and included angle. facetTolerance <= 0 uses the default.
+func EvaluateLargeRadius(radiusMM, arcAngleDeg, facetTolerance float64) (*RollingRecommendation, error) {
+	if radiusMM <= 0 || arcAngleDeg <= 0 {
+		return nil, fmt.Errorf("radius (%.1fmm) and arc angle (%.1f°) must be positive", radiusMM, arcAngleDeg)
+	}
+	if facetTolerance <= 0 {
+		facetTolerance = defaultFacetToleranceMM
+	}
+
+	// Facet sagitta for n hits over the arc: s = R * (1 - cos(arc/2n)).
+	arcRad := arcAngleDeg * math.Pi / 180.0
+	hits := 1
+	sagitta := radiusMM * (1 - math.Cos(arcRad/2))
+	for sagitta > facetTolerance && hits < maxPracticalBumpHits*2 {
+		hits++
+		sagitta = radiusMM * (1 - math.Cos(arcRad/(2*float64(hits))))
+	}
+
+	rec := &RollingRecommendation{
+		BumpHits:        hits,
+		FacetDeviation:  sagitta,
+		EstimatedMinute: float64(hits) * secondsPerBumpHit / 60.0,
+	}
+	if hits <= maxPracticalBumpHits {
+		rec.UseBumpBending = true
+		rec.Reason = fmt.Sprintf("Bump bending practical: %d hits keep faceting at %.2fmm (tolerance %.2fmm), est. %.0f min.",
+			hits, sagitta, facetTolerance, rec.EstimatedMinute)
+	} else {
+		rec.Reason = fmt.Sprintf("Bump bending impractical: %d hits needed for %.2fmm tolerance (limit %d). Recommend plate rolling.",
+			hits, facetTolerance, maxPracticalBumpHits)
+	}
+	return rec, nil
+}
+
+// RecordRollingRecommendation appends the helper's verdict to the current
+// job's notes so the decision is documented with the program.
+func (jc *JobController) RecordRollingRecommendation(rec *RollingRecommendation) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to record recommendation on")
+	}
+	if rec == nil {
+		return fmt.Errorf("recommendation is nil")
+	}
+	if jc.currentJob.Notes != "" {
+		jc.currentJob.Notes += "\n"
+	}
+	jc.currentJob.Notes += "Large-radius helper: " + rec.Reason
+	log.Printf("INFO: Rolling recommendation recorded on job '%s': %s", jc.currentJob.Name, rec.Reason)
+	return nil
+}
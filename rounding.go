@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// --- Export rounding rules ---
+// Controllers and operators expect numbers in the precision they are used
+// to: a DA-66T takes positions to 0.01mm, a paper setup sheet reads best
+// at 0.1mm and half degrees. Exports quantize their values through one
+// shared rule set instead of each picking its own format verbs, so a
+// shop configures the convention once and every generated document agrees.
+
+// RoundingRules quantizes exported values to fixed increments.
+type RoundingRules struct {
+	PositionMM float64 // Increment for lengths and positions, e.g. 0.1.
+	AngleDeg   float64 // Increment for angles, e.g. 0.5.
+}
+
+var (
+	roundingMu     sync.RWMutex
+	exportRounding = RoundingRules{PositionMM: 0.1, AngleDeg: 0.5}
+)
+
+// SetExportRounding configures the shop-wide export increments.
+func SetExportRounding(rules RoundingRules) error {
+	if rules.PositionMM <= 0 || rules.AngleDeg <= 0 {
+		return fmt.Errorf("rounding increments must be positive (got %.4fmm, %.4f°)", rules.PositionMM, rules.AngleDeg)
+	}
+	roundingMu.Lock()
+	exportRounding = rules
+	roundingMu.Unlock()
+	return nil
+}
+
+// GetExportRounding returns the active export increments.
+func GetExportRounding() RoundingRules {
+	roundingMu.RLock()
+	defer roundingMu.RUnlock()
+	return exportRounding
+}
+
+// roundTo quantizes a value to the nearest multiple of the increment.
+func roundTo(v, increment float64) float64 {
+	if increment <= 0 {
+		return v
+	}
+	return math.Round(v/increment) * increment
+}
+
+// RoundExportPosition quantizes a length or position for export.
+func RoundExportPosition(v float64) float64 { return roundTo(v, GetExportRounding().PositionMM) }
+
+// RoundExportAngle quantizes an angle for export.
+func RoundExportAngle(v float64) float64 { return roundTo(v, GetExportRounding().AngleDeg) }
+
+// exportDecimals returns how many decimals an increment needs so rounded
+// values print without false extra precision (0.25 -> 2, 0.1 -> 1, 1 -> 0).
+func exportDecimals(increment float64) int {
+	for d := 0; d <= 4; d++ {
+		scaled := increment * math.Pow(10, float64(d))
+		if math.Abs(scaled-math.Round(scaled)) < 1e-9 {
+			return d
+		}
+	}
+	return 4
+}
+
+// FormatExportPosition renders a position with the configured precision,
+// localized for display (see locale.go).
+func FormatExportPosition(v float64) string {
+	return LocalizeNumber(FormatProgramPosition(v))
+}
+
+// FormatExportAngle renders an angle with the configured precision,
+// localized for display.
+func FormatExportAngle(v float64) string {
+	return LocalizeNumber(FormatProgramAngle(v))
+}
+
+// FormatProgramPosition renders a position for machine programs, which
+// always use the dot separator regardless of locale.
+func FormatProgramPosition(v float64) string {
+	r := GetExportRounding()
+	return fmt.Sprintf("%.*f", exportDecimals(r.PositionMM), roundTo(v, r.PositionMM))
+}
+
+// FormatProgramAngle renders an angle for machine programs (dot separator).
+func FormatProgramAngle(v float64) string {
+	r := GetExportRounding()
+	return fmt.Sprintf("%.*f", exportDecimals(r.AngleDeg), roundTo(v, r.AngleDeg))
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+)
+
+// --- Configurable validation rule engine ---
+// The numeric limits that used to be scattered as hard-coded constants are
+// gathered into named rules that admins can adjust per deployment (and, as
+// machine/material profiles grow, per machine). Every violation message
+// carries its rule ID so users can tell exactly which limit fired.
+
+// LimitRule is a single min/max constraint on a numeric input.
+type LimitRule struct {
+	ID          string  // Stable identifier, e.g. "BEND.ANGLE".
+	Description string  // Human-readable subject of the rule.
+	Min, Max    float64 // Allowed inclusive range.
+	Unit        string  // Display unit, e.g. "mm" or "°".
+}
+
+// RuleSet is an editable collection of limit rules evaluated through a
+// single pipeline. It is safe for concurrent use.
+type RuleSet struct {
+	mu    sync.RWMutex
+	rules map[string]*LimitRule
+}
+
+// Rule IDs for the built-in limits.
+const (
+	RuleSheetDimension = "SHEET.DIM"
+	RuleBendRadius     = "BEND.RADIUS"
+	RuleBendAngle      = "BEND.ANGLE"
+	RuleBendPosition   = "BEND.POSITION"
+)
+
+// NewDefaultRuleSet returns a rule set seeded from the application defaults.
+func NewDefaultRuleSet() *RuleSet {
+	rs := &RuleSet{rules: make(map[string]*LimitRule)}
+	rs.put(&LimitRule{ID: RuleSheetDimension, Description: "sheet dimension", Min: minSheetDimension, Max: maxSheetDimension, Unit: "mm"})
+	rs.put(&LimitRule{ID: RuleBendRadius, Description: "bend radius", Min: minBendRadius, Max: maxBendRadius, Unit: "mm"})
+	// The angle ceiling admits the flattening band (see flattening.go) so
+	// hem sequences can target a true 180°.
+	rs.put(&LimitRule{ID: RuleBendAngle, Description: "bend angle", Min: minBendAngle, Max: maxFlatteningAngleDeg, Unit: "°"})
+	return rs
+}
+
+// put stores a rule without the configuration-lock check (internal use and
+// default seeding only).
+func (rs *RuleSet) put(rule *LimitRule) {
+	if rule == nil || rule.ID == "" {
+		return
+	}
+	rs.mu.Lock()
+	rs.rules[rule.ID] = rule
+	rs.mu.Unlock()
+	log.Printf("INFO: Validation rule '%s' set: %s %.2f-%.2f%s", rule.ID, rule.Description, rule.Min, rule.Max, rule.Unit)
+}
+
+// Set adds or replaces a rule. Intended for admin configuration screens;
+// refused while the instructor/administrator lock is engaged.
+func (rs *RuleSet) Set(rule *LimitRule) error {
+	if err := RequireConfigUnlocked("validation rule editing"); err != nil {
+		return err
+	}
+	rs.put(rule)
+	return nil
+}
+
+// Get returns the rule with the given ID, if present.
+func (rs *RuleSet) Get(id string) (*LimitRule, bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	r, ok := rs.rules[id]
+	return r, ok
+}
+
+// RuleIDs returns all rule IDs in sorted order, for configuration UIs.
+func (rs *RuleSet) RuleIDs() []string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	ids := make([]string, 0, len(rs.rules))
+	for id := range rs.rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Check evaluates the identified rule against a value. An unknown rule ID is
+// an error itself, so misconfigured pipelines fail loudly rather than
+// silently accepting input.
+func (rs *RuleSet) Check(id string, value float64) error {
+	rule, ok := rs.Get(id)
+	if !ok {
+		return fmt.Errorf("[%s] validation rule not configured", id)
+	}
+	return checkRange(rule.ID, rule.Description, value, rule.Min, rule.Max, rule.Unit)
+}
+
+// CheckRange evaluates a rule whose bounds are context-dependent (e.g. a bend
+// position bounded by the current sheet length), keeping the same message
+// format and rule ID tagging as configured rules.
+func (rs *RuleSet) CheckRange(id, description string, value, min, max float64, unit string) error {
+	return checkRange(id, description, value, min, max, unit)
+}
+
+func checkRange(id, description string, value, min, max float64, unit string) error {
+	if value < min || value > max {
+		return fmt.Errorf("[%s] %s (%.2f%s) is outside allowed range (%.2f-%.2f%s)", id, description, value, unit, min, max, unit)
+	}
+	return nil
+}
+
+// activeRules is the rule set used by the validation pipeline. A future
+// machine/material profile system can swap this per selection.
+var activeRules = NewDefaultRuleSet()
+
+// GetActiveRuleSet returns the rule set currently used for validation.
+func GetActiveRuleSet() *RuleSet { return activeRules }
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// --- Versioned data schemas and migrations ---
+// Saved job/material/tool/machine files carry an explicit schema kind and
+// version. When formats evolve, a migration function is registered for each
+// version step; loading old data runs the chain up to the current version,
+// so shipped persistence formats can change without stranding saved data.
+
+// SchemaKind identifies which document family a file belongs to.
+type SchemaKind string
+
+const (
+	SchemaJob      SchemaKind = "job"
+	SchemaMaterial SchemaKind = "material"
+	SchemaTool     SchemaKind = "tool"
+	SchemaMachine  SchemaKind = "machine"
+)
+
+// currentSchemaVersions is the version this build writes per kind. Bump a
+// kind's entry when its format changes, and register a migration for the
+// step.
+var currentSchemaVersions = map[SchemaKind]int{
+	SchemaJob:      1,
+	SchemaMaterial: 1,
+	SchemaTool:     1,
+	SchemaMachine:  1,
+}
+
+// VersionedDocument is the on-disk envelope around any persisted payload.
+type VersionedDocument struct {
+	Schema  SchemaKind      `json:"schema"`
+	Version int             `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// SchemaMigration upgrades a payload one version step for one kind.
+type SchemaMigration struct {
+	Kind        SchemaKind
+	FromVersion int
+	Apply       func(json.RawMessage) (json.RawMessage, error)
+}
+
+// migrationRegistry holds registered migrations keyed by kind and version.
+type migrationRegistry struct {
+	mu         sync.RWMutex
+	migrations map[SchemaKind]map[int]SchemaMigration
+}
+
+var migrations = &migrationRegistry{migrations: make(map[SchemaKind]map[int]SchemaMigration)}
+
+// RegisterMigration adds a migration step. Registering a duplicate step is
+// a programming error and is refused loudly.
+func RegisterMigration(m SchemaMigration) error {
+	if m.Apply == nil {
+		return fmt.Errorf("migration for %s v%d has no Apply function", m.Kind, m.FromVersion)
+	}
+	migrations.mu.Lock()
+	defer migrations.mu.Unlock()
+	byVersion, ok := migrations.migrations[m.Kind]
+	if !ok {
+		byVersion = make(map[int]SchemaMigration)
+		migrations.migrations[m.Kind] = byVersion
+	}
+	if _, exists := byVersion[m.FromVersion]; exists {
+		return fmt.Errorf("migration for %s v%d already registered", m.Kind, m.FromVersion)
+	}
+	byVersion[m.FromVersion] = m
+	return nil
+}
+
+// WrapDocument marshals a payload into the current versioned envelope.
+func WrapDocument(kind SchemaKind, payload interface{}) (*VersionedDocument, error) {
+	version, ok := currentSchemaVersions[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema kind '%s'", kind)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling %s payload: %w", kind, err)
+	}
+	return &VersionedDocument{Schema: kind, Version: version, Data: data}, nil
+}
+
+// MigrateToCurrent runs the registered migration chain on a loaded document
+// until it reaches this build's version, returning the upgraded payload.
+// Documents newer than this build are refused rather than misread.
+func MigrateToCurrent(doc *VersionedDocument) (json.RawMessage, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("document is nil")
+	}
+	target, ok := currentSchemaVersions[doc.Schema]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema kind '%s'", doc.Schema)
+	}
+	if doc.Version > target {
+		return nil, fmt.Errorf("%s document is version %d but this build supports up to %d (newer application required)",
+			doc.Schema, doc.Version, target)
+	}
+
+	data := doc.Data
+	for v := doc.Version; v < target; v++ {
+		migrations.mu.RLock()
+		m, found := migrations.migrations[doc.Schema][v]
+		migrations.mu.RUnlock()
+		if !found {
+			return nil, fmt.Errorf("no migration registered for %s v%d -> v%d", doc.Schema, v, v+1)
+		}
+		upgraded, err := m.Apply(data)
+		if err != nil {
+			return nil, fmt.Errorf("migrating %s v%d -> v%d: %w", doc.Schema, v, v+1, err)
+		}
+		data = upgraded
+		log.Printf("INFO: Migrated %s document v%d -> v%d.", doc.Schema, v, v+1)
+	}
+	return data, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// GenerateSetupSheet writes a plain-text setup sheet for a job: sheet data,
+// active tooling, the bend sequence, and any recorded warning overrides so
+// the paperwork shows exactly what the operator accepted.
+func GenerateSetupSheet(job *Job, pb *PressBrake, filePath string) error {
+	if job == nil || job.Sheet == nil {
+		return fmt.Errorf("job or sheet is nil for setup sheet generation")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s - Setup Sheet\n", appName, appVersion))
+	sb.WriteString(fmt.Sprintf("Generated: %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+
+	sb.WriteString(fmt.Sprintf("Job: %s\n", job.Name))
+	if job.OrderID != "" {
+		sb.WriteString(fmt.Sprintf("Work Order: %s\n", job.OrderID))
+	}
+	sb.WriteString(fmt.Sprintf("Sheet: %s  L:%.1fmm W:%.1fmm T:%.1fmm  Material: %s\n",
+		job.Sheet.ID, job.Sheet.OriginalLength, job.Sheet.Width, job.Sheet.Thickness, job.Sheet.Material.Name))
+
+	punchName, dieName := "None", "None"
+	if pb != nil {
+		if p := pb.GetCurrentPunch(); p != nil {
+			punchName = p.Name
+		}
+		if d := pb.GetCurrentDie(); d != nil {
+			dieName = d.Name
+		}
+	}
+	sb.WriteString(fmt.Sprintf("Tooling: Punch: %s, Die: %s\n", punchName, dieName))
+	sb.WriteString(toolStackBlock(pb))
+	sb.WriteString("\n")
+
+	sb.WriteString(fmt.Sprintf("Bend Sequence (%d steps):\n", len(job.Steps)))
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		skipped := ""
+		if step.Disabled {
+			skipped = "  [SKIPPED]"
+		}
+		sb.WriteString(fmt.Sprintf("  %2d. Pos:%7smm  Gauge:%7smm  Angle:%s  Radius:%5smm  Dir:%s%s%s\n",
+			step.SequenceOrder, FormatExportPosition(step.Position), FormatExportPosition(GaugePositionForStep(step)),
+			describeStepAngle(step, job.AngleConv), FormatExportPosition(step.Radius), step.Direction, describeStepOffset(step), skipped))
+	}
+
+	sb.WriteString(correctionsBlock(job))
+	sb.WriteString(whipSupportBlock(job))
+	sb.WriteString(limitExceptionBlock(job))
+	sb.WriteString(traceabilityBlock(job))
+
+	if len(job.Overrides) > 0 {
+		sb.WriteString(fmt.Sprintf("\nWarning Overrides (%d):\n", len(job.Overrides)))
+		for _, ov := range job.Overrides {
+			sb.WriteString(fmt.Sprintf("  [%s] step %d: %s\n    Justification: %s (%s)\n",
+				ov.RuleID, ov.StepOrder, ov.Message, ov.Justification, ov.Timestamp.Format("2006-01-02 15:04")))
+		}
+	}
+
+	log.Printf("INFO: Generating setup sheet for job '%s' to '%s'.", job.Name, filePath)
+	if err := os.WriteFile(filePath, []byte(sb.String()), 0644); err != nil {
+		log.Printf("ERROR: Failed to write setup sheet '%s': %v", filePath, err)
+		return fmt.Errorf("writing setup sheet: %w", err)
+	}
+	return nil
+}
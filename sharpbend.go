@@ -0,0 +1,25 @@
+package main
+
+// --- Sharp (zero-radius) bends ---
+// Users enter radius 0 to mean "sharp", but no bend actually achieves a
+// zero inner radius: the punch coins the material until it bottoms out
+// somewhere below half the thickness. Developed-length math must use that
+// achievable radius — feeding a literal 0 through the allowance formula
+// understates the allowance and the flat pattern comes out short. The
+// force side already handles it: AssessCoiningRisk scales to the full 5x
+// coining multiplier as the programmed radius approaches zero.
+
+// sharpBendRadiusFactor is the achievable inner radius of a coined sharp
+// bend as a fraction of the sheet thickness.
+const sharpBendRadiusFactor = 0.25
+
+// EffectiveInnerRadius maps a programmed radius to the radius the part
+// actually forms. Radii at or below the coining floor clamp to it; larger
+// radii pass through unchanged.
+func EffectiveInnerRadius(requested, thickness float64) float64 {
+	floor := thickness * sharpBendRadiusFactor
+	if requested < floor {
+		return floor
+	}
+	return requested
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// --- Released paperwork signing ---
+// An approved setup sheet must be traceable to the exact program it was
+// approved for. Releasing a sheet appends an approval block: who, when,
+// and a checksum of the job's serialized state. Re-checking the job
+// against the recorded checksum later shows whether the program changed
+// after approval.
+
+// approvalMarker opens the appended approval block in a released sheet.
+const approvalMarker = "--- APPROVED ---"
+
+// ApprovalBlock is the approval record embedded in released paperwork.
+type ApprovalBlock struct {
+	ApprovedBy  string
+	When        time.Time
+	JobChecksum string // SHA-256 over the job's canonical JSON.
+}
+
+// JobChecksum computes the checksum of a job's serialized state, used to
+// tie paperwork to one exact program version.
+func JobChecksum(j *Job) (string, error) {
+	if j == nil {
+		return "", fmt.Errorf("job is nil for checksum")
+	}
+	data, err := json.Marshal(j)
+	if err != nil {
+		return "", fmt.Errorf("serializing job for checksum: %w", err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// ReleaseSetupSheet writes the setup sheet and appends the approval block
+// for the given approver. The returned block is what got embedded.
+func ReleaseSetupSheet(job *Job, pb *PressBrake, approvedBy, filePath string) (*ApprovalBlock, error) {
+	if strings.TrimSpace(approvedBy) == "" {
+		return nil, fmt.Errorf("an approver name is required to release a setup sheet")
+	}
+	if err := GenerateSetupSheet(job, pb, filePath); err != nil {
+		return nil, err
+	}
+	sum, err := JobChecksum(job)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &ApprovalBlock{ApprovedBy: approvedBy, When: time.Now(), JobChecksum: sum}
+	text := fmt.Sprintf("\n%s\nApproved by: %s\nDate: %s\nProgram checksum (SHA-256): %s\n",
+		approvalMarker, block.ApprovedBy, block.When.Format("2006-01-02 15:04:05"), block.JobChecksum)
+
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("appending approval block: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(text); err != nil {
+		return nil, fmt.Errorf("writing approval block: %w", err)
+	}
+	log.Printf("INFO: Released setup sheet '%s' approved by %s (checksum %.12s...).", filePath, approvedBy, sum)
+	return block, nil
+}
+
+// VerifyReleasedSheet checks a released sheet's recorded checksum against
+// the current state of the job. It returns an error when the sheet has no
+// approval block or the program has changed since approval.
+func VerifyReleasedSheet(job *Job, filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("reading released sheet: %w", err)
+	}
+	content := string(data)
+	idx := strings.Index(content, "Program checksum (SHA-256): ")
+	if idx < 0 {
+		return fmt.Errorf("'%s' carries no approval block", filePath)
+	}
+	recorded := strings.TrimSpace(strings.SplitN(content[idx+len("Program checksum (SHA-256): "):], "\n", 2)[0])
+
+	current, err := JobChecksum(job)
+	if err != nil {
+		return err
+	}
+	if recorded != current {
+		return fmt.Errorf("job has changed since approval: recorded %.12s..., current %.12s...", recorded, current)
+	}
+	return nil
+}
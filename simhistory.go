@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// --- Simulation results history ---
+// A job gets edited and re-simulated many times before it runs on the
+// machine. Keeping each run's summary with the job shows how tonnage,
+// cycle time and warnings moved across edits — useful both for catching
+// regressions ("why did tonnage double?") and for justifying the final
+// program.
+
+// maxSimHistoryEntries caps the per-job history; the oldest entries are
+// dropped first.
+const maxSimHistoryEntries = 50
+
+// SimulationRecord is one simulation run's summary as stored with the job.
+type SimulationRecord struct {
+	When         time.Time
+	PunchName    string
+	DieName      string
+	MaxTonnage   float64
+	CycleSeconds float64
+	Warnings     []string
+}
+
+// RecordSimulationRun summarizes the current job state on the given
+// machine and appends it to the job's history.
+func (jc *JobController) RecordSimulationRun(pb *PressBrake) (*SimulationRecord, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no active job to record a simulation run for")
+	}
+	sum, err := SummarizeJobSimulation(jc.currentJob, pb)
+	if err != nil {
+		return nil, fmt.Errorf("summarizing run for history: %w", err)
+	}
+
+	rec := SimulationRecord{
+		When:       time.Now(),
+		MaxTonnage: sum.MaxTonnage,
+		Warnings:   sum.Warnings,
+	}
+	if pb != nil {
+		if p := pb.GetCurrentPunch(); p != nil {
+			rec.PunchName = p.Name
+		}
+		if d := pb.GetCurrentDie(); d != nil {
+			rec.DieName = d.Name
+		}
+		rec.CycleSeconds = EstimateJobCycleSeconds(jc.currentJob, pb.CycleTimes)
+	}
+
+	jc.currentJob.SimHistory = append(jc.currentJob.SimHistory, rec)
+	if len(jc.currentJob.SimHistory) > maxSimHistoryEntries {
+		jc.currentJob.SimHistory = jc.currentJob.SimHistory[len(jc.currentJob.SimHistory)-maxSimHistoryEntries:]
+	}
+	return &rec, nil
+}
+
+// FormatSimHistory renders the job's run history newest-first for the
+// results-history panel.
+func FormatSimHistory(j *Job) string {
+	if j == nil || len(j.SimHistory) == 0 {
+		return "No simulation runs recorded."
+	}
+	var sb strings.Builder
+	for i := len(j.SimHistory) - 1; i >= 0; i-- {
+		rec := j.SimHistory[i]
+		sb.WriteString(fmt.Sprintf("%s  %s/%s  %.1ft  %.0fs",
+			rec.When.Format("01-02 15:04"), rec.PunchName, rec.DieName, rec.MaxTonnage, rec.CycleSeconds))
+		if len(rec.Warnings) > 0 {
+			sb.WriteString(fmt.Sprintf("  (%d warnings)", len(rec.Warnings)))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// --- Job family size tables ---
+// Product families (enclosures, duct sections) are one parametric job
+// plus a table of sizes. The size table is a CSV whose header names the
+// variables; each row becomes one instantiated variant, simulated and
+// given its own setup sheet in a batch run.
+
+// SizeTableRow is one variant's variable values.
+type SizeTableRow map[string]float64
+
+// SizeVariantResult is the outcome for one row of the table.
+type SizeVariantResult struct {
+	Row       SizeTableRow
+	Job       *Job   // Non-nil when the variant instantiated cleanly.
+	SheetPath string // Written setup sheet path ("" when skipped/failed).
+	Problem   string // Why the variant failed; empty on success.
+}
+
+// LoadSizeTableCSV reads a size table: first line is variable names, each
+// further line one variant. Rows with non-numeric cells are skipped with
+// a warning rather than failing the whole table.
+func LoadSizeTableCSV(path string) ([]SizeTableRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening size table: %w", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing size table '%s': %w", path, err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("size table '%s' needs a header and at least one row", path)
+	}
+
+	header := records[0]
+	var rows []SizeTableRow
+	for i, rec := range records[1:] {
+		if len(rec) != len(header) {
+			log.Printf("WARNING: Size table row %d has %d cells, expected %d; skipped.", i+2, len(rec), len(header))
+			continue
+		}
+		row := make(SizeTableRow, len(header))
+		ok := true
+		for k, cell := range rec {
+			v, convErr := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+			if convErr != nil {
+				log.Printf("WARNING: Size table row %d: bad value %q for '%s'; row skipped.", i+2, cell, header[k])
+				ok = false
+				break
+			}
+			row[strings.TrimSpace(header[k])] = v
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("size table '%s' contained no usable rows", path)
+	}
+	return rows, nil
+}
+
+// GenerateJobFamily instantiates and simulates every size-table row of a
+// parametric job, writing a setup sheet per variant into outDir. Failing
+// variants are reported, not fatal.
+func GenerateJobFamily(pj *ParametricJob, rows []SizeTableRow, pb *PressBrake, outDir string) ([]SizeVariantResult, error) {
+	if pj == nil {
+		return nil, fmt.Errorf("parametric job is nil for family generation")
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no size rows to generate")
+	}
+
+	results := make([]SizeVariantResult, 0, len(rows))
+	good := 0
+	for _, row := range rows {
+		res := SizeVariantResult{Row: row}
+		job, err := pj.Instantiate(row)
+		if err != nil {
+			res.Problem = err.Error()
+			results = append(results, res)
+			continue
+		}
+		res.Job = job
+
+		if _, err := SummarizeJobSimulation(job, pb); err != nil {
+			res.Problem = fmt.Sprintf("simulation: %v", err)
+			results = append(results, res)
+			continue
+		}
+		path := filepath.Join(outDir, fmt.Sprintf("setup_%s.txt", strings.ReplaceAll(job.Name, " ", "_")))
+		if err := GenerateSetupSheet(job, pb, path); err != nil {
+			res.Problem = fmt.Sprintf("setup sheet: %v", err)
+		} else {
+			res.SheetPath = path
+			good++
+		}
+		results = append(results, res)
+	}
+	log.Printf("INFO: Job family '%s': %d/%d variants generated into '%s'.", pj.Name, good, len(rows), outDir)
+	return results, nil
+}
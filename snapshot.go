@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// --- Application state snapshots ---
+// Undo/redo, autosave, scripting and test harnesses all need the same
+// primitive: freeze the current state, get it back later, byte-for-byte.
+// A snapshot is a deep copy of the job (via its JSON form, so it shares
+// nothing with the live tree) plus the tool selection by name. Restoring
+// re-resolves the tools through the ToolingManager, so a snapshot taken
+// before a tool library edit still restores cleanly when the names exist.
+
+// AppSnapshot is a serializable freeze of the editable application state.
+type AppSnapshot struct {
+	Taken     time.Time
+	Job       *Job   // Deep copy; nil when no job was active.
+	PunchName string // Installed punch at capture time; "" for none.
+	DieName   string // Installed die at capture time; "" for none.
+	JobDirty  bool
+}
+
+// deepCopyJob clones a job through its JSON form.
+func deepCopyJob(j *Job) (*Job, error) {
+	if j == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(j)
+	if err != nil {
+		return nil, fmt.Errorf("serializing job for snapshot: %w", err)
+	}
+	var clone Job
+	if err := json.Unmarshal(data, &clone); err != nil {
+		return nil, fmt.Errorf("cloning job from snapshot: %w", err)
+	}
+	return &clone, nil
+}
+
+// CaptureSnapshot freezes the current job and tool selection.
+func (ac *AppController) CaptureSnapshot() (*AppSnapshot, error) {
+	snap := &AppSnapshot{Taken: time.Now(), JobDirty: ac.jobDirty}
+	job, err := deepCopyJob(ac.currentJob)
+	if err != nil {
+		return nil, err
+	}
+	snap.Job = job
+	if ac.pressBrake != nil {
+		if p := ac.pressBrake.GetCurrentPunch(); p != nil {
+			snap.PunchName = p.Name
+		}
+		if d := ac.pressBrake.GetCurrentDie(); d != nil {
+			snap.DieName = d.Name
+		}
+	}
+	return snap, nil
+}
+
+// RestoreSnapshot replaces the current job and tool selection with the
+// snapshot's state and refreshes the dependent displays.
+func (ac *AppController) RestoreSnapshot(snap *AppSnapshot) error {
+	if snap == nil {
+		return fmt.Errorf("snapshot is nil")
+	}
+	job, err := deepCopyJob(snap.Job)
+	if err != nil {
+		return err
+	}
+	ac.currentJob = job
+	if ac.jobController != nil {
+		ac.jobController.currentJob = job
+	}
+
+	if ac.pressBrake != nil && ac.toolingManager != nil {
+		if snap.PunchName != "" {
+			if p, ok := ac.toolingManager.GetPunchByName(snap.PunchName); ok {
+				if err := ac.pressBrake.SetPunch(p); err != nil {
+					log.Printf("WARNING: Snapshot restore could not install punch '%s': %v", snap.PunchName, err)
+				}
+			} else {
+				log.Printf("WARNING: Snapshot names unknown punch '%s'; keeping current punch.", snap.PunchName)
+			}
+		}
+		if snap.DieName != "" {
+			if d, ok := ac.toolingManager.GetDieByName(snap.DieName); ok {
+				ac.pressBrake.SetDie(d)
+			} else {
+				log.Printf("WARNING: Snapshot names unknown die '%s'; keeping current die.", snap.DieName)
+			}
+		}
+	}
+
+	ac.jobDirty = snap.JobDirty
+	ac.statusBar.SetDirty(snap.JobDirty)
+	ac.updateToolingStatusDisplay()
+	GetEventBus().Publish(Event{Type: EventJobChanged, Job: ac.currentJob})
+	ac.signalUIUpdate()
+	return nil
+}
+
+// MarshalSnapshot serializes a snapshot for storage or transport.
+func MarshalSnapshot(snap *AppSnapshot) ([]byte, error) {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("serializing snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalSnapshot reads a snapshot back from its serialized form.
+func UnmarshalSnapshot(data []byte) (*AppSnapshot, error) {
+	var snap AppSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("parsing snapshot: %w", err)
+	}
+	return &snap, nil
+}
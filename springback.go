@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Springback prediction ---
+// A bend relaxes when the punch lifts: the elastic part of the deformation
+// recovers and the angle opens back up. The classic estimate relates the
+// bent radius to the material's yield stress and Young's modulus —
+// softer, springier combinations (high yield, low modulus, large radius,
+// thin sheet) recover more. The compensation is the over-bend target:
+// program the suggested angle and the part relaxes to the one you wanted.
+
+// SpringbackResult is the per-bend prediction.
+type SpringbackResult struct {
+	SpringbackDeg  float64 // Degrees the bend opens after release.
+	OverBendTarget float64 // Angle to program so the part relaxes to target.
+	KRatio         float64 // Radius-retention ratio (1 = no springback).
+}
+
+// PredictSpringback estimates elastic recovery for one bend. The model is
+// the standard three-term radius-ratio formula with the achievable inner
+// radius (a programmed 0 clamps to the coining floor first).
+func PredictSpringback(sheet *SheetMetal, step *BendStep, punch *Punch) (*SpringbackResult, error) {
+	if sheet == nil || step == nil {
+		return nil, fmt.Errorf("sheet or step is nil for springback prediction")
+	}
+	if sheet.Thickness <= 0 {
+		return nil, fmt.Errorf("sheet thickness must be positive for springback prediction")
+	}
+	e := sheet.Material.TensileModulus * 1000 // GPa -> MPa.
+	if e <= 0 {
+		return nil, fmt.Errorf("material '%s' has no tensile modulus", sheet.Material.Name)
+	}
+	yield := EffectiveYieldStress(sheet.Material)
+
+	r := EffectiveInnerRadius(step.Radius, sheet.Thickness)
+	if punch != nil && punch.Radius > r {
+		r = punch.Radius // The part cannot form tighter than the punch tip.
+	}
+	t := sheet.Thickness
+
+	// K = Ri/Rf = 4(Ri*Y/(E*t))^3 - 3(Ri*Y/(E*t)) + 1
+	x := r * yield / (e * t)
+	k := 4*math.Pow(x, 3) - 3*x + 1
+	if k > 1 {
+		k = 1
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("springback model out of range (K=%.3f) for radius %.2fmm", k, r)
+	}
+
+	// The swept angle scales with the radius ratio: the bend opens by the
+	// unrecovered fraction of the sweep.
+	swept := 180.0 - step.TargetAngle
+	springback := swept * (1 - k)
+	over := step.TargetAngle - springback
+	if over < minBendAngle {
+		over = minBendAngle
+	}
+	return &SpringbackResult{SpringbackDeg: springback, OverBendTarget: over, KRatio: k}, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// --- Status bar state ---
+// The old status line was a single string with a clear timer that raced
+// against newer messages (the timer compared text suffixes to guess
+// whether its message was still showing). The status bar replaces it:
+// persistent sections always reflect the current machine, tooling and
+// unit system, the transient message area carries the latest event, and
+// expiry uses a generation counter — a stale timer simply finds a newer
+// generation and does nothing.
+
+// transientStatusTTL is how long a non-error transient message stays up.
+const transientStatusTTL = 7 * time.Second
+
+// StatusBar holds the redesigned status line state. All access goes
+// through its methods; background goroutines post messages freely.
+type StatusBar struct {
+	mu sync.Mutex
+
+	// Persistent sections.
+	machine string
+	tooling string
+	units   string
+	dirty   bool
+
+	// Transient message area.
+	msg        string
+	msgIsError bool
+	msgGen     uint64
+
+	invalidate func() // Called after any change to request a redraw.
+}
+
+// NewStatusBar returns a status bar that calls invalidate on changes.
+func NewStatusBar(invalidate func()) *StatusBar {
+	return &StatusBar{units: "mm", invalidate: invalidate}
+}
+
+// SetMachine updates the persistent machine section.
+func (sb *StatusBar) SetMachine(name string) {
+	sb.mu.Lock()
+	sb.machine = name
+	sb.mu.Unlock()
+	sb.redraw()
+}
+
+// SetTooling updates the persistent tooling section.
+func (sb *StatusBar) SetTooling(punch, die string) {
+	sb.mu.Lock()
+	sb.tooling = punch + "/" + die
+	sb.mu.Unlock()
+	sb.redraw()
+}
+
+// SetUnits updates the persistent unit-system section.
+func (sb *StatusBar) SetUnits(units string) {
+	sb.mu.Lock()
+	sb.units = units
+	sb.mu.Unlock()
+	sb.redraw()
+}
+
+// SetDirty updates the unsaved-changes indicator.
+func (sb *StatusBar) SetDirty(dirty bool) {
+	sb.mu.Lock()
+	changed := sb.dirty != dirty
+	sb.dirty = dirty
+	sb.mu.Unlock()
+	if changed {
+		sb.redraw()
+	}
+}
+
+// Post shows a transient message. Errors stay until replaced; other
+// messages expire after transientStatusTTL. A message that has already
+// been replaced when its timer fires is left alone (generation check).
+func (sb *StatusBar) Post(msg string, isError bool) {
+	sb.mu.Lock()
+	sb.msgGen++
+	gen := sb.msgGen
+	sb.msg = msg
+	sb.msgIsError = isError
+	sb.mu.Unlock()
+	sb.redraw()
+
+	if isError {
+		return
+	}
+	time.AfterFunc(transientStatusTTL, func() {
+		sb.mu.Lock()
+		if sb.msgGen == gen {
+			sb.msg = ""
+		}
+		expired := sb.msgGen == gen
+		sb.mu.Unlock()
+		if expired {
+			sb.redraw()
+		}
+	})
+}
+
+// Snapshot returns the rendered persistent sections, the transient
+// message, and whether the message is an error.
+func (sb *StatusBar) Snapshot() (sections, msg string, isError bool) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	var parts []string
+	if sb.machine != "" {
+		parts = append(parts, sb.machine)
+	}
+	if sb.tooling != "" {
+		parts = append(parts, sb.tooling)
+	}
+	parts = append(parts, sb.units)
+	if sb.dirty {
+		parts = append(parts, "● unsaved")
+	}
+	return strings.Join(parts, "  |  "), sb.msg, sb.msgIsError
+}
+
+func (sb *StatusBar) redraw() {
+	if sb.invalidate != nil {
+		sb.invalidate()
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"time"
+)
+
+// --- STEP export of the folded part ---
+// Downstream fixture design wants the folded part as a solid, not a
+// drawing. The exporter extrudes the folded cross-section (offset half a
+// thickness to each side of the mid-line, arcs already faceted) along the
+// sheet width and writes it as a faceted B-rep in STEP AP214: planar
+// faces bounded by poly loops, which every CAD viewer opens.
+
+// stepWriter numbers and accumulates STEP data-section entities.
+type stepWriter struct {
+	nextID int
+	sb     strings.Builder
+}
+
+// add writes one entity and returns its #id.
+func (w *stepWriter) add(def string) int {
+	w.nextID++
+	w.sb.WriteString(fmt.Sprintf("#%d=%s;\n", w.nextID, def))
+	return w.nextID
+}
+
+// foldedSolidPolygon is the cross-section outline of the solid part: the
+// mid-line offset t/2 to each side, joined into one closed polygon.
+func foldedSolidPolygon(cs *CrossSection) []pt2 {
+	mid := cs.FlattenedPolyline()
+	if len(mid) < 2 {
+		return nil
+	}
+	t := cs.ThicknessMM
+	normalAt := func(i int) pt2 {
+		// Average of the adjacent segment normals.
+		var nx, ny float64
+		if i > 0 {
+			dx, dy := mid[i].X-mid[i-1].X, mid[i].Y-mid[i-1].Y
+			l := math.Hypot(dx, dy)
+			if l > 0 {
+				nx += -dy / l
+				ny += dx / l
+			}
+		}
+		if i < len(mid)-1 {
+			dx, dy := mid[i+1].X-mid[i].X, mid[i+1].Y-mid[i].Y
+			l := math.Hypot(dx, dy)
+			if l > 0 {
+				nx += -dy / l
+				ny += dx / l
+			}
+		}
+		l := math.Hypot(nx, ny)
+		if l == 0 {
+			return pt2{0, 1}
+		}
+		return pt2{nx / l, ny / l}
+	}
+	polygon := make([]pt2, 0, 2*len(mid))
+	for i, p := range mid {
+		n := normalAt(i)
+		polygon = append(polygon, pt2{p.X + n.X*t/2, p.Y + n.Y*t/2})
+	}
+	for i := len(mid) - 1; i >= 0; i-- {
+		n := normalAt(i)
+		polygon = append(polygon, pt2{mid[i].X - n.X*t/2, mid[i].Y - n.Y*t/2})
+	}
+	return polygon
+}
+
+// ExportSTEP writes the folded part as a faceted AP214 solid.
+func ExportSTEP(j *Job, filePath string) error {
+	if j == nil || j.Sheet == nil {
+		return fmt.Errorf("job or sheet is nil for STEP export")
+	}
+	cs, err := BuildCrossSection(j.Sheet)
+	if err != nil {
+		return err
+	}
+	polygon := foldedSolidPolygon(cs)
+	if len(polygon) < 3 {
+		return fmt.Errorf("job '%s' has no cross-section to export", j.Name)
+	}
+	half := j.Sheet.Width / 2
+
+	var w stepWriter
+	point := func(x, y, z float64) int {
+		return w.add(fmt.Sprintf("CARTESIAN_POINT('',(%.4f,%.4f,%.4f))", x, y, z))
+	}
+	direction := func(x, y, z float64) int {
+		return w.add(fmt.Sprintf("DIRECTION('',(%.6f,%.6f,%.6f))", x, y, z))
+	}
+	// Shared vertex points of the front (z=+half) and back (z=-half) rings.
+	n := len(polygon)
+	front := make([]int, n)
+	back := make([]int, n)
+	for i, p := range polygon {
+		front[i] = point(p.X, p.Y, half)
+		back[i] = point(p.X, p.Y, -half)
+	}
+
+	refs := func(ids []int) string {
+		parts := make([]string, len(ids))
+		for i, id := range ids {
+			parts[i] = fmt.Sprintf("#%d", id)
+		}
+		return strings.Join(parts, ",")
+	}
+	face := func(loop []int, origin pt2, z float64, nx, ny, nz float64) int {
+		loopID := w.add(fmt.Sprintf("POLY_LOOP('',(%s))", refs(loop)))
+		boundID := w.add(fmt.Sprintf("FACE_OUTER_BOUND('',#%d,.T.)", loopID))
+		originID := point(origin.X, origin.Y, z)
+		normalID := direction(nx, ny, nz)
+		// Any reference direction perpendicular to the normal works.
+		rx, ry, rz := 1.0, 0.0, 0.0
+		if math.Abs(nx) > 0.9 {
+			rx, ry, rz = 0.0, 1.0, 0.0
+		}
+		refID := direction(rx, ry, rz)
+		axisID := w.add(fmt.Sprintf("AXIS2_PLACEMENT_3D('',#%d,#%d,#%d)", originID, normalID, refID))
+		planeID := w.add(fmt.Sprintf("PLANE('',#%d)", axisID))
+		return w.add(fmt.Sprintf("FACE_SURFACE('',(#%d),#%d,.T.)", boundID, planeID))
+	}
+
+	var faces []int
+	faces = append(faces, face(front, polygon[0], half, 0, 0, 1))
+	reversed := make([]int, n)
+	for i := range back {
+		reversed[i] = back[n-1-i]
+	}
+	faces = append(faces, face(reversed, polygon[0], -half, 0, 0, -1))
+	for i := 0; i < n; i++ {
+		k := (i + 1) % n
+		dx, dy := polygon[k].X-polygon[i].X, polygon[k].Y-polygon[i].Y
+		l := math.Hypot(dx, dy)
+		if l == 0 {
+			continue
+		}
+		faces = append(faces, face(
+			[]int{front[i], front[k], back[k], back[i]},
+			polygon[i], half, dy/l, -dx/l, 0))
+	}
+
+	shellID := w.add(fmt.Sprintf("CLOSED_SHELL('',(%s))", refs(faces)))
+	brepID := w.add(fmt.Sprintf("FACETED_BREP('',#%d)", shellID))
+
+	// Units, context and minimal product structure.
+	lenUnit := w.add("(LENGTH_UNIT()NAMED_UNIT(*)SI_UNIT(.MILLI.,.METRE.))")
+	angUnit := w.add("(NAMED_UNIT(*)PLANE_ANGLE_UNIT()SI_UNIT($,.RADIAN.))")
+	solidUnit := w.add("(NAMED_UNIT(*)SI_UNIT($,.STERADIAN.)SOLID_ANGLE_UNIT())")
+	uncertainty := w.add(fmt.Sprintf("UNCERTAINTY_MEASURE_WITH_UNIT(LENGTH_MEASURE(0.001),#%d,'distance_accuracy_value','')", lenUnit))
+	ctx := w.add(fmt.Sprintf("(GEOMETRIC_REPRESENTATION_CONTEXT(3)GLOBAL_UNCERTAINTY_ASSIGNED_CONTEXT((#%d))GLOBAL_UNIT_ASSIGNED_CONTEXT((#%d,#%d,#%d))REPRESENTATION_CONTEXT('',''))",
+		uncertainty, lenUnit, angUnit, solidUnit))
+	originID := point(0, 0, 0)
+	zDir := direction(0, 0, 1)
+	xDir := direction(1, 0, 0)
+	axisID := w.add(fmt.Sprintf("AXIS2_PLACEMENT_3D('',#%d,#%d,#%d)", originID, zDir, xDir))
+	shapeRep := w.add(fmt.Sprintf("FACETED_BREP_SHAPE_REPRESENTATION('%s',(#%d,#%d),#%d)", j.Name, axisID, brepID, ctx))
+
+	appCtx := w.add("APPLICATION_CONTEXT('automotive design')")
+	prodCtx := w.add(fmt.Sprintf("PRODUCT_CONTEXT('',#%d,'mechanical')", appCtx))
+	product := w.add(fmt.Sprintf("PRODUCT('%s','%s','',(#%d))", j.Name, j.Name, prodCtx))
+	formation := w.add(fmt.Sprintf("PRODUCT_DEFINITION_FORMATION('','',#%d)", product))
+	defCtx := w.add(fmt.Sprintf("PRODUCT_DEFINITION_CONTEXT('part definition',#%d,'design')", appCtx))
+	prodDef := w.add(fmt.Sprintf("PRODUCT_DEFINITION('design','',#%d,#%d)", formation, defCtx))
+	prodShape := w.add(fmt.Sprintf("PRODUCT_DEFINITION_SHAPE('','',#%d)", prodDef))
+	w.add(fmt.Sprintf("SHAPE_DEFINITION_REPRESENTATION(#%d,#%d)", prodShape, shapeRep))
+
+	var out strings.Builder
+	out.WriteString("ISO-10303-21;\nHEADER;\n")
+	out.WriteString("FILE_DESCRIPTION(('Folded sheet metal part'),'2;1');\n")
+	out.WriteString(fmt.Sprintf("FILE_NAME('%s','%s',('%s'),(''),'','%s','');\n",
+		strings.ReplaceAll(j.Name, "'", ""), time.Now().Format("2006-01-02T15:04:05"), appName, appName+" "+appVersion))
+	out.WriteString("FILE_SCHEMA(('AUTOMOTIVE_DESIGN { 1 0 10303 214 1 1 1 1 }'));\nENDSEC;\nDATA;\n")
+	out.WriteString(w.sb.String())
+	out.WriteString("ENDSEC;\nEND-ISO-10303-21;\n")
+
+	if err := os.WriteFile(filePath, []byte(out.String()), 0644); err != nil {
+		return fmt.Errorf("writing STEP '%s': %w", filePath, err)
+	}
+	log.Printf("INFO: STEP export for job '%s' written to '%s' (%d face(s)).", j.Name, filePath, len(faces))
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// --- Raw stock traceability ---
+// Shops working to traceability requirements must show which material
+// heat/lot each run was bent from. The certificate record attaches to
+// the job and flows through to the setup sheet; the certificate PDF
+// itself joins the job's attachments so it travels with the program.
+
+// StockCertificate identifies the raw material a job run uses.
+type StockCertificate struct {
+	HeatNumber string // Mill heat number from the certificate.
+	LotNumber  string // Supplier lot, when distinct from the heat.
+	Supplier   string
+	CertPath   string // Path to the certificate document (usually PDF).
+}
+
+// AttachStockCertificate records the material traceability data on the
+// active job. The certificate file, when given and present, is also added
+// to the job's attachments.
+func (jc *JobController) AttachStockCertificate(cert StockCertificate) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to attach a stock certificate to")
+	}
+	if strings.TrimSpace(cert.HeatNumber) == "" {
+		return fmt.Errorf("a heat number is required for stock traceability")
+	}
+
+	jc.currentJob.StockCert = &cert
+	if cert.CertPath != "" {
+		if _, err := os.Stat(cert.CertPath); err != nil {
+			log.Printf("WARNING: Stock certificate file '%s' not accessible: %v", cert.CertPath, err)
+		} else if _, err := jc.AddAttachmentToCurrentJob(cert.CertPath); err != nil {
+			log.Printf("WARNING: Could not attach certificate file: %v", err)
+		}
+	}
+	log.Printf("INFO: Job '%s': stock certificate heat '%s' attached.", jc.currentJob.Name, cert.HeatNumber)
+	return nil
+}
+
+// traceabilityBlock renders the material traceability lines for reports,
+// or "" when the job has no certificate.
+func traceabilityBlock(j *Job) string {
+	if j == nil || j.StockCert == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\nMaterial Traceability:\n")
+	sb.WriteString(fmt.Sprintf("  Heat: %s\n", j.StockCert.HeatNumber))
+	if j.StockCert.LotNumber != "" {
+		sb.WriteString(fmt.Sprintf("  Lot: %s\n", j.StockCert.LotNumber))
+	}
+	if j.StockCert.Supplier != "" {
+		sb.WriteString(fmt.Sprintf("  Supplier: %s\n", j.StockCert.Supplier))
+	}
+	if j.StockCert.CertPath != "" {
+		sb.WriteString(fmt.Sprintf("  Certificate: %s\n", j.StockCert.CertPath))
+	}
+	return sb.String()
+}
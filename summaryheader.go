@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+)
+
+// --- Job summary header ---
+// The essentials of the open job — what part, what blank, how many bends,
+// the worst tonnage, the time and whether it validates — used to live in
+// four different panels. The header strip keeps them in one always-visible
+// line that recomputes every frame, so it tracks edits live.
+
+// JobSummary is the digest shown in the header strip.
+type JobSummary struct {
+	PartName   string
+	BlankDesc  string // L x W x T.
+	Material   string
+	BendCount  int // Enabled steps only.
+	MaxTonnes  float64
+	CycleSec   float64
+	IssueCount int // Steps failing validation.
+}
+
+// SummarizeJob computes the header digest for the job on this machine.
+func SummarizeJob(j *Job, pb *PressBrake) JobSummary {
+	s := JobSummary{PartName: "-", BlankDesc: "-", Material: "-"}
+	if j == nil || j.Sheet == nil {
+		return s
+	}
+	s.PartName = j.Name
+	s.BlankDesc = fmt.Sprintf("%.0fx%.0fx%.1fmm", j.Sheet.OriginalLength, j.Sheet.Width, j.Sheet.Thickness)
+	s.Material = string(j.Sheet.Material.Name)
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		s.BendCount++
+		if err := validateBendStepParams(j.Sheet, step.Position, step.TargetAngle, step.Radius); err != nil {
+			s.IssueCount++
+		}
+		if pb != nil {
+			if tonnes, err := RequiredTonnesForStep(j.Sheet, step, pb.GetCurrentDie(), pb); err == nil && tonnes > s.MaxTonnes {
+				s.MaxTonnes = tonnes
+			}
+		}
+	}
+	if pb != nil {
+		s.CycleSec = EstimateJobCycleSeconds(j, pb.CycleTimes)
+	}
+	return s
+}
+
+// String renders the digest as the single header line.
+func (s JobSummary) String() string {
+	status := "OK"
+	if s.IssueCount > 0 {
+		status = fmt.Sprintf("%d issue(s)", s.IssueCount)
+	}
+	return fmt.Sprintf("%s  |  %s %s  |  %d bend(s)  |  max %.1ft  |  cycle %.0fs  |  %s",
+		s.PartName, s.BlankDesc, s.Material, s.BendCount, s.MaxTonnes, s.CycleSec, status)
+}
+
+// layoutSummaryHeader draws the always-visible summary strip.
+func (ac *AppController) layoutSummaryHeader(gtx layout.Context) layout.Dimensions {
+	summary := SummarizeJob(ac.currentJob, ac.pressBrake)
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			size := image.Point{X: gtx.Constraints.Max.X, Y: gtx.Constraints.Min.Y}
+			defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+			paint.ColorOp{Color: ac.th.Palette.Bg}.Add(gtx.Ops)
+			paint.PaintOp{}.Add(gtx.Ops)
+			return layout.Dimensions{Size: size}
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(ac.th, ac.th.TextSize*0.9, summary.String())
+			if summary.IssueCount > 0 {
+				lbl.Color = ac.th.Palette.ContrastBg
+			}
+			return layout.Inset{Top: unit.Dp(4), Bottom: unit.Dp(4), Left: unit.Dp(8), Right: unit.Dp(8)}.Layout(gtx, lbl.Layout)
+		}),
+	)
+}
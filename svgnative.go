@@ -0,0 +1,487 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+)
+
+// --- Native SVG rendering ---
+// Every drawing the simulator exports is generated here, from a small
+// SVG vocabulary: rect, line, polyline, circle, path (M/L/A), text and
+// one translate/scale group. This renderer parses exactly that subset
+// back into Gio ops so the profile panel shows the generated file
+// itself — pins, reference marker, labels and all — instead of a
+// placeholder.
+
+// svgStroke is one drawable outline in document coordinates.
+type svgStroke struct {
+	Points []f32.Point
+	Color  color.NRGBA
+	Width  float32
+	Dash   []float32 // Empty = solid.
+	Closed bool
+	Fill   *color.NRGBA // Non-nil fills the polygon too.
+}
+
+// svgText is one positioned label in document coordinates.
+type svgText struct {
+	Pos   f32.Point
+	Size  float32
+	Color color.NRGBA
+	Text  string
+}
+
+// SVGDocument is a parsed drawing ready to lay out.
+type SVGDocument struct {
+	Width, Height float32
+	Strokes       []svgStroke
+	Texts         []svgText
+}
+
+// svgTransform is the translate/scale state while parsing.
+type svgTransform struct{ tx, ty, sx, sy float64 }
+
+func (t svgTransform) apply(x, y float64) f32.Point {
+	return f32.Pt(float32(t.tx+x*t.sx), float32(t.ty+y*t.sy))
+}
+
+// parseSVGColor understands #rgb/#rrggbb and "none".
+func parseSVGColor(s string) (color.NRGBA, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "none") {
+		return color.NRGBA{}, false
+	}
+	if !strings.HasPrefix(s, "#") {
+		return color.NRGBA{A: 0xFF}, true // Unknown named color: draw black.
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return color.NRGBA{}, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}, true
+}
+
+// svgAttr returns an attribute value ("" when absent).
+func svgAttr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func svgAttrFloat(el xml.StartElement, name string, def float64) float64 {
+	s := svgAttr(el, name)
+	if s == "" {
+		return def
+	}
+	// Dimension attributes may carry units or styles we don't use.
+	s = strings.TrimSuffix(strings.TrimSpace(s), "px")
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// parseSVGTransform reads "translate(a,b) scale(c,d)" (either optional).
+func parseSVGTransform(s string, base svgTransform) svgTransform {
+	t := base
+	read := func(fn string) ([]float64, bool) {
+		idx := strings.Index(s, fn+"(")
+		if idx < 0 {
+			return nil, false
+		}
+		rest := s[idx+len(fn)+1:]
+		end := strings.Index(rest, ")")
+		if end < 0 {
+			return nil, false
+		}
+		fields := strings.FieldsFunc(rest[:end], func(r rune) bool { return r == ',' || r == ' ' })
+		var vals []float64
+		for _, f := range fields {
+			if v, err := strconv.ParseFloat(f, 64); err == nil {
+				vals = append(vals, v)
+			}
+		}
+		return vals, len(vals) > 0
+	}
+	if vals, ok := read("translate"); ok {
+		t.tx += vals[0] * t.sx
+		if len(vals) > 1 {
+			t.ty += vals[1] * t.sy
+		}
+	}
+	if vals, ok := read("scale"); ok {
+		t.sx *= vals[0]
+		if len(vals) > 1 {
+			t.sy *= vals[1]
+		} else {
+			t.sy *= vals[0]
+		}
+	}
+	return t
+}
+
+// parseDashArray reads "a b" / "a,b" into scaled dash lengths.
+func parseDashArray(s string, scale float64) []float32 {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+	var dash []float32
+	for _, f := range fields {
+		if v, err := strconv.ParseFloat(f, 64); err == nil {
+			dash = append(dash, float32(v*scale))
+		}
+	}
+	return dash
+}
+
+// arcPolyline appends an endpoint-parameterized circular SVG arc as
+// segments (rx == ry in everything we generate).
+func arcPolyline(from f32.Point, rx float64, largeArc, sweep bool, to f32.Point) []f32.Point {
+	x1, y1 := float64(from.X), float64(from.Y)
+	x2, y2 := float64(to.X), float64(to.Y)
+	dx, dy := (x1-x2)/2, (y1-y2)/2
+	dist2 := dx*dx + dy*dy
+	if rx <= 0 || dist2 == 0 {
+		return []f32.Point{to}
+	}
+	r := rx
+	if r*r < dist2 { // Radius too small to reach: scale up per spec.
+		r = math.Sqrt(dist2)
+	}
+	// Center of the circle through both endpoints (endpoint
+	// parameterization from the SVG spec, circular case).
+	h := math.Sqrt(math.Max(0, r*r-dist2))
+	sign := 1.0
+	if largeArc == sweep {
+		sign = -1.0
+	}
+	cx := (x1+x2)/2 + sign*h*dy/math.Sqrt(dist2)
+	cy := (y1+y2)/2 + sign*h*(-dx)/math.Sqrt(dist2)
+
+	a1 := math.Atan2(y1-cy, x1-cx)
+	a2 := math.Atan2(y2-cy, x2-cx)
+	sweepAng := a2 - a1
+	if sweep && sweepAng < 0 {
+		sweepAng += 2 * math.Pi
+	}
+	if !sweep && sweepAng > 0 {
+		sweepAng -= 2 * math.Pi
+	}
+	var pts []f32.Point
+	for s := 1; s <= profileArcSegments; s++ {
+		ang := a1 + sweepAng*float64(s)/profileArcSegments
+		pts = append(pts, f32.Pt(float32(cx+r*math.Cos(ang)), float32(cy+r*math.Sin(ang))))
+	}
+	return pts
+}
+
+// parsePathData reads an M/L/A path into a polyline in local coordinates.
+func parsePathData(d string, t svgTransform) []f32.Point {
+	fields := strings.Fields(d)
+	var pts []f32.Point
+	i := 0
+	next := func() (float64, bool) {
+		if i >= len(fields) {
+			return 0, false
+		}
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return 0, false
+		}
+		i++
+		return v, true
+	}
+	for i < len(fields) {
+		cmd := fields[i]
+		i++
+		switch cmd {
+		case "M", "L":
+			x, okX := next()
+			y, okY := next()
+			if okX && okY {
+				pts = append(pts, t.apply(x, y))
+			}
+		case "A":
+			rx, _ := next()
+			next() // ry (== rx here)
+			next() // x-axis rotation
+			la, _ := next()
+			sw, _ := next()
+			x, okX := next()
+			y, okY := next()
+			if okX && okY && len(pts) > 0 {
+				// The sweep flag is given in pre-transform orientation; a
+				// negative Y scale mirrors it.
+				sweep := sw != 0
+				if t.sy < 0 {
+					sweep = !sweep
+				}
+				scaledR := rx * math.Abs(t.sx)
+				pts = append(pts, arcPolyline(pts[len(pts)-1], scaledR, la != 0, sweep, t.apply(x, y))...)
+			}
+		default:
+			// Unknown command: skip token.
+		}
+	}
+	return pts
+}
+
+// ParseSVGFile loads one of the simulator's generated SVGs.
+func ParseSVGFile(path string) (*SVGDocument, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading SVG '%s': %w", path, err)
+	}
+	doc := &SVGDocument{}
+	dec := xml.NewDecoder(strings.NewReader(string(data)))
+	stack := []svgTransform{{sx: 1, sy: 1}}
+	var textPending *svgText
+
+	for {
+		tok, tokErr := dec.Token()
+		if tokErr != nil {
+			break
+		}
+		switch el := tok.(type) {
+		case xml.StartElement:
+			t := stack[len(stack)-1]
+			switch el.Name.Local {
+			case "svg":
+				doc.Width = float32(svgAttrFloat(el, "width", 400))
+				doc.Height = float32(svgAttrFloat(el, "height", 300))
+			case "g":
+				t = parseSVGTransform(svgAttr(el, "transform"), t)
+			case "rect":
+				x, y := svgAttrFloat(el, "x", 0), svgAttrFloat(el, "y", 0)
+				w, h := svgAttrFloat(el, "width", 0), svgAttrFloat(el, "height", 0)
+				st := svgStroke{
+					Points: []f32.Point{t.apply(x, y), t.apply(x+w, y), t.apply(x+w, y+h), t.apply(x, y+h)},
+					Width:  float32(svgAttrFloat(el, "stroke-width", 1) * math.Abs(t.sx)),
+					Closed: true,
+				}
+				if c, ok := parseSVGColor(svgAttr(el, "fill")); ok {
+					fill := c
+					st.Fill = &fill
+				}
+				if c, ok := parseSVGColor(svgAttr(el, "stroke")); ok {
+					st.Color = c
+				} else if st.Fill != nil {
+					st.Color = *st.Fill
+					st.Width = 0.5
+				}
+				doc.Strokes = append(doc.Strokes, st)
+			case "line":
+				st := svgStroke{
+					Points: []f32.Point{
+						t.apply(svgAttrFloat(el, "x1", 0), svgAttrFloat(el, "y1", 0)),
+						t.apply(svgAttrFloat(el, "x2", 0), svgAttrFloat(el, "y2", 0)),
+					},
+					Width: float32(svgAttrFloat(el, "stroke-width", 1) * math.Abs(t.sx)),
+					Dash:  parseDashArray(svgAttr(el, "stroke-dasharray"), math.Abs(t.sx)),
+				}
+				if c, ok := parseSVGColor(svgAttr(el, "stroke")); ok {
+					st.Color = c
+					doc.Strokes = append(doc.Strokes, st)
+				}
+			case "polyline":
+				fields := strings.FieldsFunc(svgAttr(el, "points"), func(r rune) bool { return r == ',' || r == ' ' })
+				var pts []f32.Point
+				for k := 0; k+1 < len(fields); k += 2 {
+					x, errX := strconv.ParseFloat(fields[k], 64)
+					y, errY := strconv.ParseFloat(fields[k+1], 64)
+					if errX == nil && errY == nil {
+						pts = append(pts, t.apply(x, y))
+					}
+				}
+				if c, ok := parseSVGColor(svgAttr(el, "stroke")); ok && len(pts) > 1 {
+					doc.Strokes = append(doc.Strokes, svgStroke{
+						Points: pts, Color: c,
+						Width: float32(svgAttrFloat(el, "stroke-width", 1) * math.Abs(t.sx)),
+						Dash:  parseDashArray(svgAttr(el, "stroke-dasharray"), math.Abs(t.sx)),
+					})
+				}
+			case "circle":
+				cx, cy := svgAttrFloat(el, "cx", 0), svgAttrFloat(el, "cy", 0)
+				r := svgAttrFloat(el, "r", 0)
+				var pts []f32.Point
+				for s := 0; s <= profileArcSegments; s++ {
+					ang := 2 * math.Pi * float64(s) / profileArcSegments
+					pts = append(pts, t.apply(cx+r*math.Cos(ang), cy+r*math.Sin(ang)))
+				}
+				st := svgStroke{Points: pts, Width: 1, Closed: true}
+				if c, ok := parseSVGColor(svgAttr(el, "fill")); ok {
+					fill := c
+					st.Fill = &fill
+					st.Color = c
+				}
+				doc.Strokes = append(doc.Strokes, st)
+			case "path":
+				if pts := parsePathData(svgAttr(el, "d"), t); len(pts) > 1 {
+					if c, ok := parseSVGColor(svgAttr(el, "stroke")); ok {
+						doc.Strokes = append(doc.Strokes, svgStroke{
+							Points: pts, Color: c,
+							Width: float32(svgAttrFloat(el, "stroke-width", 1) * math.Abs(t.sx)),
+						})
+					}
+				}
+			case "text":
+				col := color.NRGBA{A: 0xFF}
+				if c, ok := parseSVGColor(svgAttr(el, "fill")); ok {
+					col = c
+				}
+				textPending = &svgText{
+					Pos:   t.apply(svgAttrFloat(el, "x", 0), svgAttrFloat(el, "y", 0)),
+					Size:  float32(svgAttrFloat(el, "font-size", 10)),
+					Color: col,
+				}
+			}
+			if el.Name.Local == "g" || el.Name.Local == "svg" {
+				stack = append(stack, t)
+			}
+		case xml.CharData:
+			if textPending != nil {
+				textPending.Text += strings.TrimSpace(string(el))
+			}
+		case xml.EndElement:
+			switch el.Name.Local {
+			case "g", "svg":
+				if len(stack) > 1 {
+					stack = stack[:len(stack)-1]
+				}
+			case "text":
+				if textPending != nil && textPending.Text != "" {
+					doc.Texts = append(doc.Texts, *textPending)
+				}
+				textPending = nil
+			}
+		}
+	}
+	if doc.Width <= 0 || doc.Height <= 0 {
+		return nil, fmt.Errorf("SVG '%s' has no usable viewport", path)
+	}
+	return doc, nil
+}
+
+// fillPolygon fills a closed point loop.
+func fillPolygon(gtx layout.Context, pts []f32.Point, col color.NRGBA) {
+	if len(pts) < 3 {
+		return
+	}
+	var p clip.Path
+	p.Begin(gtx.Ops)
+	p.MoveTo(pts[0])
+	for _, pt := range pts[1:] {
+		p.LineTo(pt)
+	}
+	p.Close()
+	paint.FillShape(gtx.Ops, col, clip.Outline{Path: p.End()}.Op())
+}
+
+// dashSegments splits a polyline into on/off dashes.
+func dashSegments(pts []f32.Point, dash []float32) [][]f32.Point {
+	if len(dash) < 2 {
+		return [][]f32.Point{pts}
+	}
+	var out [][]f32.Point
+	on := true
+	remain := dash[0]
+	cur := []f32.Point{pts[0]}
+	di := 0
+	for i := 1; i < len(pts); i++ {
+		a, b := pts[i-1], pts[i]
+		segLen := float32(math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y)))
+		pos := float32(0)
+		for segLen-pos > remain {
+			pos += remain
+			frac := pos / segLen
+			mid := f32.Pt(a.X+(b.X-a.X)*frac, a.Y+(b.Y-a.Y)*frac)
+			if on {
+				cur = append(cur, mid)
+				out = append(out, cur)
+			}
+			cur = []f32.Point{mid}
+			on = !on
+			di = (di + 1) % len(dash)
+			remain = dash[di]
+		}
+		remain -= segLen - pos
+		if on {
+			cur = append(cur, b)
+		} else {
+			cur = []f32.Point{b}
+		}
+	}
+	if on && len(cur) > 1 {
+		out = append(out, cur)
+	}
+	return out
+}
+
+// Layout draws the document scaled to fit the available area.
+func (doc *SVGDocument) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	size := gtx.Constraints.Max
+	scale := float32(math.Min(
+		float64(size.X)/float64(doc.Width),
+		float64(size.Y)/float64(doc.Height),
+	))
+	place := func(p f32.Point) f32.Point { return f32.Pt(p.X*scale, p.Y*scale) }
+
+	for _, st := range doc.Strokes {
+		pts := make([]f32.Point, len(st.Points))
+		for i, p := range st.Points {
+			pts[i] = place(p)
+		}
+		if st.Closed && len(pts) > 2 {
+			pts = append(pts, pts[0])
+		}
+		if st.Fill != nil {
+			fillPolygon(gtx, pts, *st.Fill)
+		}
+		w := st.Width * scale
+		if w < 1 {
+			w = 1
+		}
+		if len(st.Dash) >= 2 {
+			dash := make([]float32, len(st.Dash))
+			for i, d := range st.Dash {
+				dash[i] = d * scale
+			}
+			for _, seg := range dashSegments(pts, dash) {
+				strokePolyline(gtx, seg, st.Color, w)
+			}
+		} else {
+			strokePolyline(gtx, pts, st.Color, w)
+		}
+	}
+	for _, tx := range doc.Texts {
+		lbl := material.Label(th, unit.Sp(tx.Size*scale), tx.Text)
+		lbl.Color = tx.Color
+		offset := op.Offset(image.Pt(int(tx.Pos.X*scale), int((tx.Pos.Y-tx.Size)*scale))).Push(gtx.Ops)
+		lbl.Layout(gtx)
+		offset.Pop()
+	}
+	return layout.Dimensions{Size: image.Pt(int(float32(doc.Width)*scale), int(float32(doc.Height)*scale))}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// --- Job tags and saved filters ---
+// Free-form tags ("stainless", "rush", "enclosure") keep a growing job
+// library searchable. Saved filters are just named tag sets the job
+// browser can re-apply with one click.
+
+// AddTag attaches a tag to the job. Tags are case-insensitive and
+// duplicates are ignored; the call reports whether anything changed.
+func (j *Job) AddTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return false
+	}
+	for _, t := range j.Tags {
+		if t == tag {
+			return false
+		}
+	}
+	j.Tags = append(j.Tags, tag)
+	sort.Strings(j.Tags)
+	return true
+}
+
+// RemoveTag detaches a tag from the job, reporting whether it was present.
+func (j *Job) RemoveTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for i, t := range j.Tags {
+		if t == tag {
+			j.Tags = append(j.Tags[:i], j.Tags[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// HasTag reports whether the job carries the tag.
+func (j *Job) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range j.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// JobFilter is a saved job-browser filter: a job matches when it carries
+// every listed tag (AND semantics; an empty filter matches everything).
+type JobFilter struct {
+	Name string
+	Tags []string
+}
+
+// Matches reports whether the job satisfies the filter.
+func (f *JobFilter) Matches(j *Job) bool {
+	if j == nil {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !j.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterJobs returns the jobs matching the filter, preserving order.
+func FilterJobs(jobs []*Job, f *JobFilter) []*Job {
+	if f == nil {
+		return jobs
+	}
+	var out []*Job
+	for _, j := range jobs {
+		if f.Matches(j) {
+			out = append(out, j)
+		}
+	}
+	return out
+}
+
+// filterStore holds the saved filters, keyed by name.
+var filterStore = struct {
+	mu      sync.RWMutex
+	filters map[string]*JobFilter
+}{filters: make(map[string]*JobFilter)}
+
+// SaveJobFilter stores a named filter, replacing any existing one with the
+// same name.
+func SaveJobFilter(f *JobFilter) error {
+	if f == nil || strings.TrimSpace(f.Name) == "" {
+		return fmt.Errorf("filter must have a name")
+	}
+	filterStore.mu.Lock()
+	filterStore.filters[f.Name] = f
+	filterStore.mu.Unlock()
+	log.Printf("INFO: Saved job filter '%s' (%d tags).", f.Name, len(f.Tags))
+	return nil
+}
+
+// GetJobFilter returns a saved filter by name.
+func GetJobFilter(name string) (*JobFilter, bool) {
+	filterStore.mu.RLock()
+	defer filterStore.mu.RUnlock()
+	f, ok := filterStore.filters[name]
+	return f, ok
+}
+
+// JobFilterNames returns the saved filter names, sorted.
+func JobFilterNames() []string {
+	filterStore.mu.RLock()
+	defer filterStore.mu.RUnlock()
+	names := make([]string, 0, len(filterStore.filters))
+	for name := range filterStore.filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"time"
+)
+
+// --- Teach mode (experimental, read-only) ---
+// During machine setup the simulator can run as a visual twin: a listener
+// accepts live axis position lines from a supported controller (or the
+// mock used for development) and mirrors the real stroke in the
+// animation. Nothing is ever written back to the controller — the
+// connection is consumed read-only. The wire format is one report per
+// line:
+//
+//   POS <Y mm> <stroke mm>   current ram position and full stroke length
+//   STEP <n>                 controller advanced to bend n (1-based)
+//   BYE                      controller closed the session
+//
+// Any unknown line is ignored so firmware variants don't break the feed.
+
+// TeachListener mirrors controller axis positions into a StrokeAnimation.
+type TeachListener struct {
+	Addr     string        // host:port to listen on.
+	Timeout  time.Duration // Per-connection read timeout.
+	anim     *StrokeAnimation
+	onChange func()
+}
+
+// NewTeachListener returns a listener feeding the given animation.
+// onChange runs after every mirrored update (typically a window
+// invalidate).
+func NewTeachListener(addr string, anim *StrokeAnimation, onChange func()) (*TeachListener, error) {
+	if anim == nil {
+		return nil, fmt.Errorf("animation state is nil for teach mode")
+	}
+	return &TeachListener{Addr: addr, Timeout: 30 * time.Second, anim: anim, onChange: onChange}, nil
+}
+
+// applyTeachLine mirrors one report line into the animation. Returns
+// false for the session-ending BYE.
+func (tl *TeachListener) applyTeachLine(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return true
+	}
+	switch fields[0] {
+	case "POS":
+		if len(fields) < 3 {
+			return true
+		}
+		y, errY := ParseUserFloat(fields[1])
+		stroke, errS := ParseUserFloat(fields[2])
+		if errY != nil || errS != nil || stroke <= 0 {
+			return true
+		}
+		pct := y / stroke
+		if pct < 0 {
+			pct = 0
+		}
+		if pct > 1 {
+			pct = 1
+		}
+		tl.anim.mu.Lock()
+		tl.anim.RamPct = pct
+		tl.anim.mu.Unlock()
+	case "STEP":
+		if len(fields) < 2 {
+			return true
+		}
+		n, err := ParseUserFloat(fields[1])
+		if err != nil || n < 1 {
+			return true
+		}
+		tl.anim.mu.Lock()
+		tl.anim.StepIndex = int(n) - 1
+		tl.anim.RamPct = 0
+		tl.anim.PinchHoldPct = 0
+		tl.anim.PinchReleased = false
+		tl.anim.mu.Unlock()
+	case "BYE":
+		return false
+	default:
+		// Unknown report: ignore.
+		return true
+	}
+	if tl.onChange != nil {
+		tl.onChange()
+	}
+	return true
+}
+
+// mirror consumes one controller session.
+func (tl *TeachListener) mirror(conn net.Conn) {
+	defer conn.Close()
+	log.Printf("INFO: Teach mode: controller connected from %s.", conn.RemoteAddr())
+	scanner := bufio.NewScanner(conn)
+	for {
+		if tl.Timeout > 0 {
+			if err := conn.SetReadDeadline(time.Now().Add(tl.Timeout)); err != nil {
+				break
+			}
+		}
+		if !scanner.Scan() {
+			break
+		}
+		if !tl.applyTeachLine(scanner.Text()) {
+			break
+		}
+	}
+	log.Printf("INFO: Teach mode: session from %s ended.", conn.RemoteAddr())
+}
+
+// Listen accepts controller connections until ctx is cancelled. One
+// session is mirrored at a time; concurrent connects queue on accept.
+func (tl *TeachListener) Listen(ctx context.Context) error {
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(ctx, "tcp", tl.Addr)
+	if err != nil {
+		return fmt.Errorf("teach mode listen on '%s': %w", tl.Addr, err)
+	}
+	defer ln.Close()
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+	log.Printf("INFO: Teach mode listening on %s (read-only).", tl.Addr)
+	for {
+		conn, acceptErr := ln.Accept()
+		if acceptErr != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("teach mode accept: %w", acceptErr)
+		}
+		tl.mirror(conn)
+	}
+}
+
+// MirrorStream mirrors an already-open report stream (a serial port file
+// descriptor, or a test reader) into the animation.
+func (tl *TeachListener) MirrorStream(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if !tl.applyTeachLine(scanner.Text()) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading teach stream: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import "log"
+
+// --- Temperature-compensated material behavior (advanced physics) ---
+// Thick plate and some alloys are formed warm, which lowers yield stress
+// and with it force and springback. This is opt-in: with the advanced
+// physics toggle off, all models see the room-temperature values unchanged.
+
+// AdvancedPhysicsSettings gates the optional, more detailed material models.
+type AdvancedPhysicsSettings struct {
+	Enabled        bool    // Master toggle for advanced physics effects.
+	WorkpieceTempC float64 // Workpiece temperature in °C (20 = room temp).
+}
+
+// advancedPhysics holds the active advanced-physics configuration.
+var advancedPhysics = AdvancedPhysicsSettings{WorkpieceTempC: 20}
+
+// SetAdvancedPhysics updates the advanced physics configuration.
+func SetAdvancedPhysics(s AdvancedPhysicsSettings) {
+	advancedPhysics = s
+	log.Printf("INFO: Advanced physics: enabled=%v, workpiece temperature %.0f°C.", s.Enabled, s.WorkpieceTempC)
+}
+
+// EffectiveYieldStress returns the material's yield stress adjusted for the
+// configured workpiece temperature when advanced physics is enabled. The
+// model is a simple linear softening above 150°C (roughly right for carbon
+// steel; alloy-specific curves can refine this later).
+func EffectiveYieldStress(mat MaterialDetails) float64 {
+	y := mat.YieldStress
+	if !advancedPhysics.Enabled {
+		return y
+	}
+	t := advancedPhysics.WorkpieceTempC
+	if t <= 150 {
+		return y
+	}
+	// ~35% yield reduction at 500°C, clamped so the value stays physical.
+	factor := 1.0 - (t-150)*0.001
+	if factor < 0.4 {
+		factor = 0.4
+	}
+	return y * factor
+}
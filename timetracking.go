@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// --- Cycle time estimation and actual time tracking ---
+// The estimator predicts production time per part from per-operation
+// parameters; operators (or the REST API) record actual times against it.
+// The comparison both reports schedule accuracy and feeds future
+// calibration of the model parameters.
+
+// CycleTimeModel holds the per-operation time parameters of a machine.
+type CycleTimeModel struct {
+	StrokeSec    float64 // One ram stroke (approach, bend, return).
+	GaugeMoveSec float64 // Back gauge repositioning between bends.
+	FlipSec      float64 // Operator flipping/rotating the part.
+	RetractSec   float64 // Extra gauge travel when a step retracts mid-stroke.
+	PinchSec     float64 // Operator confirmation hold at the pinch point.
+}
+
+// defaultCycleTimeModel is a reasonable starting point for a mid-size brake;
+// the calibration wizard refines it per machine.
+var defaultCycleTimeModel = CycleTimeModel{StrokeSec: 6, GaugeMoveSec: 3, FlipSec: 8, RetractSec: 1.5, PinchSec: 2}
+
+// EstimateJobCycleSeconds predicts the per-part cycle time for a job: one
+// stroke and gauge move per step, plus a flip whenever the bend direction
+// changes between consecutive steps.
+func EstimateJobCycleSeconds(j *Job, model CycleTimeModel) float64 {
+	if j == nil || len(j.Steps) == 0 {
+		return 0
+	}
+	total := 0.0
+	var prevDir BendDirection
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		total += model.StrokeSec + model.GaugeMoveSec
+		if step.GaugeRetract {
+			total += model.RetractSec
+		}
+		if step.PinchConfirm {
+			total += model.PinchSec
+		}
+		if i > 0 && step.Direction != prevDir {
+			total += model.FlipSec
+		}
+		prevDir = step.Direction
+	}
+	total += WhipSupportExtraSeconds(j)
+	return total
+}
+
+// TimeRecord is one actual production time reported for a job.
+type TimeRecord struct {
+	Start    time.Time
+	Duration time.Duration
+	Source   string // "operator" for the in-app timer, "api" for REST reports.
+}
+
+// StartJobTimer begins timing the current job. Only one timer runs at once.
+func (jc *JobController) StartJobTimer() error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to time")
+	}
+	if !jc.timerStart.IsZero() {
+		return fmt.Errorf("a job timer is already running (started %s)", jc.timerStart.Format("15:04:05"))
+	}
+	jc.timerStart = time.Now()
+	log.Printf("INFO: Job timer started for '%s'.", jc.currentJob.Name)
+	return nil
+}
+
+// StopJobTimer stops the running timer and records the duration on the job.
+func (jc *JobController) StopJobTimer() (*TimeRecord, error) {
+	if jc.currentJob == nil {
+		return nil, fmt.Errorf("no current job to stop timing")
+	}
+	if jc.timerStart.IsZero() {
+		return nil, fmt.Errorf("no job timer is running")
+	}
+	rec := TimeRecord{Start: jc.timerStart, Duration: time.Since(jc.timerStart), Source: "operator"}
+	jc.timerStart = time.Time{}
+	jc.currentJob.TimeRecords = append(jc.currentJob.TimeRecords, rec)
+	log.Printf("INFO: Job timer stopped for '%s': %.0fs recorded.", jc.currentJob.Name, rec.Duration.Seconds())
+	return &rec, nil
+}
+
+// RecordExternalTime records an actual time reported from outside the UI
+// (e.g. the REST API).
+func (jc *JobController) RecordExternalTime(d time.Duration) error {
+	if jc.currentJob == nil {
+		return fmt.Errorf("no current job to record time on")
+	}
+	if d <= 0 {
+		return fmt.Errorf("reported duration must be positive")
+	}
+	jc.currentJob.TimeRecords = append(jc.currentJob.TimeRecords, TimeRecord{Start: time.Now().Add(-d), Duration: d, Source: "api"})
+	return nil
+}
+
+// ActualVsEstimatedReport summarizes recorded times against the estimate.
+func ActualVsEstimatedReport(j *Job, model CycleTimeModel) string {
+	if j == nil {
+		return "No job."
+	}
+	est := EstimateJobCycleSeconds(j, model)
+	if len(j.TimeRecords) == 0 {
+		return fmt.Sprintf("Job '%s': estimated %.0fs/part, no actual times recorded yet.", j.Name, est)
+	}
+	sum := 0.0
+	for _, rec := range j.TimeRecords {
+		sum += rec.Duration.Seconds()
+	}
+	avg := sum / float64(len(j.TimeRecords))
+	delta := 0.0
+	if est > 0 {
+		delta = (avg - est) / est * 100
+	}
+	return fmt.Sprintf("Job '%s': estimated %.0fs/part, actual avg %.0fs over %d record(s) (%+.0f%%).",
+		j.Name, est, avg, len(j.TimeRecords), delta)
+}
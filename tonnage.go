@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+)
+
+// --- Required tonnage per bend ---
+// The per-meter force estimators (air bend, coining, flattening) answer
+// "how hard per meter"; the machine cares about the total over the bend
+// width against its rated capacity. This module closes that loop: tonnage
+// per step, and a per-job check ProcessJob runs against the PressBrake's
+// configured MaxTonnage.
+
+// kNPerTonne converts force to tonnes (1 tonne ~ 9.81 kN).
+const kNPerTonne = 9.81
+
+// RequiredTonnesForStep computes the total force for one bend in tonnes,
+// using the force model appropriate to the step (air bend, coining
+// multiplier, or flattening).
+func RequiredTonnesForStep(sheet *SheetMetal, step *BendStep, die *Die, pb *PressBrake) (float64, error) {
+	if sheet == nil || step == nil || die == nil {
+		return 0, fmt.Errorf("sheet, step or die is nil for tonnage calculation")
+	}
+	if sheet.Width <= 0 {
+		return 0, fmt.Errorf("sheet width must be positive for tonnage calculation")
+	}
+	assess, err := AssessCoiningRisk(sheet, step, die, pb)
+	if err != nil {
+		return 0, err
+	}
+	return assess.RequiredKNPerM * (sheet.Width / 1000.0) / kNPerTonne, nil
+}
+
+// CheckJobTonnage compares every enabled step's demand against the
+// machine's rated capacity and returns one warning per overload.
+func CheckJobTonnage(j *Job, pb *PressBrake) ([]string, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for tonnage check")
+	}
+	if pb == nil || pb.MaxTonnage <= 0 {
+		return nil, nil // No rated capacity configured; nothing to check.
+	}
+	die := pb.GetCurrentDie()
+	if die == nil {
+		return nil, fmt.Errorf("no die installed for tonnage check")
+	}
+	var warnings []string
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		tonnes, err := RequiredTonnesForStep(j.Sheet, step, die, pb)
+		if err != nil {
+			continue
+		}
+		if tonnes > pb.MaxTonnage {
+			warnings = append(warnings, fmt.Sprintf(
+				"step %d: requires %.1ft, exceeding machine capacity (%.0ft)", i+1, tonnes, pb.MaxTonnage))
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// --- Tonnage distribution along the bed ---
+// A wide part does not load the bed evenly: the frame deflects most at
+// mid-bed, an off-center station skews the load toward one housing, and
+// both show up as angle variation across the part. This module samples
+// the per-meter force along the bend length with those effects applied
+// and renders the result as a small chart for the step being diagnosed.
+
+const (
+	// tonnageChartSamples is the number of positions sampled along the bend.
+	tonnageChartSamples = 32
+	// ramSagFactor is the relative force lost at mid-bed from frame
+	// deflection at full load, without crowning compensation.
+	ramSagFactor = 0.08
+)
+
+// TonnageSample is the local load at one position along the bend.
+type TonnageSample struct {
+	PosMM  float64 // Position along the bend, from its left end.
+	KNPerM float64
+}
+
+// TonnageDistribution is the sampled load profile for one step.
+type TonnageDistribution struct {
+	Samples    []TonnageSample
+	MeanKNPerM float64
+	MaxSkewPct float64 // Largest deviation from the mean, in percent.
+	BendLenMM  float64
+}
+
+// ComputeTonnageDistribution samples the load along one step's bend
+// length, accounting for station placement on the bed and frame sag.
+func ComputeTonnageDistribution(sheet *SheetMetal, step *BendStep, die *Die, pb *PressBrake) (*TonnageDistribution, error) {
+	if sheet == nil || step == nil || die == nil || pb == nil {
+		return nil, fmt.Errorf("sheet, step, die or press brake is nil for tonnage distribution")
+	}
+	assess, err := AssessCoiningRisk(sheet, step, die, pb)
+	if err != nil {
+		return nil, err
+	}
+	base := assess.RequiredKNPerM
+
+	dist := &TonnageDistribution{MeanKNPerM: base, BendLenMM: sheet.Width}
+	bedLen := pb.BedLength()
+	start, _, onBed := sheetBedSpan(sheet, step.StationOffsetMM, pb)
+
+	for i := 0; i <= tonnageChartSamples; i++ {
+		pos := sheet.Width * float64(i) / float64(tonnageChartSamples)
+		intensity := 1.0
+		if onBed && bedLen > 0 {
+			// Frame sag: parabola peaking at mid-bed, zero at the housings.
+			u := 2*(start+pos)/bedLen - 1 // -1 at left housing, +1 at right.
+			if u < -1 {
+				u = -1
+			}
+			if u > 1 {
+				u = 1
+			}
+			intensity = 1 - ramSagFactor*(1-u*u)
+		}
+		local := base * intensity
+		dist.Samples = append(dist.Samples, TonnageSample{PosMM: pos, KNPerM: local})
+		if skew := math.Abs(local-base) / base * 100; base > 0 && skew > dist.MaxSkewPct {
+			dist.MaxSkewPct = skew
+		}
+	}
+	return dist, nil
+}
+
+// GenerateTonnageChartSVG renders a distribution as a small line chart
+// using the active render profile.
+func GenerateTonnageChartSVG(dist *TonnageDistribution, filePath string) error {
+	if dist == nil || len(dist.Samples) == 0 {
+		return fmt.Errorf("no tonnage distribution to chart")
+	}
+	rp := GetExportRenderProfile()
+	const svgW, svgH, pad = 360.0, 160.0, 24.0
+
+	maxVal := 0.0
+	for _, s := range dist.Samples {
+		if s.KNPerM > maxVal {
+			maxVal = s.KNPerM
+		}
+	}
+	if maxVal <= 0 {
+		return fmt.Errorf("tonnage distribution is all zero")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f">`+"\n", svgW, svgH))
+	sb.WriteString(fmt.Sprintf(`<rect width="100%%" height="100%%" fill="%s"/>`+"\n", rp.Background))
+
+	pts := make([]string, 0, len(dist.Samples))
+	for _, s := range dist.Samples {
+		x := pad + (svgW-2*pad)*s.PosMM/dist.BendLenMM
+		y := svgH - pad - (svgH-2*pad)*s.KNPerM/maxVal
+		pts = append(pts, fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+	sb.WriteString(fmt.Sprintf(`<polyline points="%s" fill="none" stroke="%s" stroke-width="%.1f"/>`+"\n",
+		strings.Join(pts, " "), rp.OutlineColor, rp.OutlineWeight))
+
+	// Mean reference line.
+	yMean := svgH - pad - (svgH-2*pad)*dist.MeanKNPerM/maxVal
+	sb.WriteString(fmt.Sprintf(`<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="%.1f" stroke-dasharray="4 3"/>`+"\n",
+		pad, yMean, svgW-pad, yMean, rp.TextColor, rp.DetailWeight))
+	sb.WriteString(fmt.Sprintf(`<text x="%.1f" y="14" font-size="10" fill="%s">Tonnage along bend: mean %.0f kN/m, skew %.1f%%</text>`+"\n",
+		pad, rp.TextColor, dist.MeanKNPerM, dist.MaxSkewPct))
+
+	sb.WriteString("</svg>\n")
+	if err := os.WriteFile(filePath, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("writing tonnage chart '%s': %w", filePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// --- Job-level tooling lock ---
+// A job that has been simulated and accepted was accepted with one
+// particular punch and die; swapping either afterwards silently
+// invalidates the results. Locking records the tool names plus the
+// geometry that drove the numbers, and tool changes are refused while the
+// lock holds. The geometry copy also lets us warn loudly when a later
+// tool-library edit changes a tool a locked job depends on.
+
+// ToolingLock freezes a job's accepted tool selection.
+type ToolingLock struct {
+	PunchName   string
+	DieName     string
+	PunchRadius float64 // Punch tip radius at lock time (mm).
+	DieVOpening float64 // Die V opening at lock time (mm).
+	LockedAt    time.Time
+}
+
+// LockTooling freezes the job to the press brake's installed tools.
+func (j *Job) LockTooling(pb *PressBrake) error {
+	if pb == nil {
+		return fmt.Errorf("no press brake to lock tooling from")
+	}
+	punch, die := pb.GetCurrentPunch(), pb.GetCurrentDie()
+	if punch == nil || die == nil {
+		return fmt.Errorf("cannot lock tooling: no punch/die installed")
+	}
+	j.ToolLock = &ToolingLock{
+		PunchName:   punch.Name,
+		DieName:     die.Name,
+		PunchRadius: punch.Radius,
+		DieVOpening: die.VOpening,
+		LockedAt:    time.Now(),
+	}
+	log.Printf("INFO: Job '%s' tooling locked to punch '%s' / die '%s'.", j.Name, punch.Name, die.Name)
+	return nil
+}
+
+// UnlockTooling releases the lock; the caller decides who may do this.
+func (j *Job) UnlockTooling() {
+	if j.ToolLock != nil {
+		log.Printf("INFO: Job '%s' tooling unlocked.", j.Name)
+	}
+	j.ToolLock = nil
+}
+
+// ToolingLocked reports whether the job's tool selection is frozen.
+func (j *Job) ToolingLocked() bool { return j != nil && j.ToolLock != nil }
+
+// CheckLockedToolGeometry compares a locked job's recorded tool geometry
+// against the current tool library. It returns one warning per mismatch
+// and logs each; an empty result means the lock still matches the library.
+func CheckLockedToolGeometry(j *Job, tm *ToolingManager) []string {
+	if j == nil || j.ToolLock == nil || tm == nil {
+		return nil
+	}
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		msg := fmt.Sprintf(format, args...)
+		warnings = append(warnings, msg)
+		log.Printf("WARNING: %s", msg)
+	}
+	lock := j.ToolLock
+	if punch, ok := tm.GetPunchByName(lock.PunchName); !ok {
+		warn("Locked job '%s': punch '%s' no longer exists in the tool library.", j.Name, lock.PunchName)
+	} else if punch.Radius != lock.PunchRadius {
+		warn("Locked job '%s': punch '%s' tip radius changed %.2fmm -> %.2fmm since lock.",
+			j.Name, lock.PunchName, lock.PunchRadius, punch.Radius)
+	}
+	if die, ok := tm.GetDieByName(lock.DieName); !ok {
+		warn("Locked job '%s': die '%s' no longer exists in the tool library.", j.Name, lock.DieName)
+	} else if die.VOpening != lock.DieVOpening {
+		warn("Locked job '%s': die '%s' V opening changed %.1fmm -> %.1fmm since lock.",
+			j.Name, lock.DieName, lock.DieVOpening, die.VOpening)
+	}
+	return warnings
+}
+
+// blockIfToolingLocked refuses a tool change for the current job and
+// tells the user how to proceed. Reports whether the change was blocked.
+func (ac *AppController) blockIfToolingLocked() bool {
+	if ac.currentJob == nil || !ac.currentJob.ToolingLocked() {
+		return false
+	}
+	ac.updateStatus(fmt.Sprintf("Tooling is locked for job '%s' (accepted %s). Unlock the job to change tools.",
+		ac.currentJob.Name, ac.currentJob.ToolLock.LockedAt.Format("2006-01-02")), true)
+	return true
+}
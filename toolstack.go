@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// --- Die holders and risers ---
+// Between the bed and the die there is usually more steel than people
+// remember: a die holder, and on tall jobs one or two riser blocks. Each
+// one eats open height, and a forgotten riser is a classic cause of a
+// part that "fit yesterday". The stack is modeled on the machine so the
+// daylight check subtracts it and the setup sheet draws it.
+
+// DieRiser is one element under the die (holder or riser block).
+type DieRiser struct {
+	Name     string
+	HeightMM float64
+}
+
+// AddDieRiser appends an element to the machine's die stack.
+func (pb *PressBrake) AddDieRiser(r DieRiser) error {
+	if r.Name == "" {
+		return fmt.Errorf("die riser needs a name")
+	}
+	if r.HeightMM <= 0 {
+		return fmt.Errorf("die riser '%s' height must be positive (got %.1f)", r.Name, r.HeightMM)
+	}
+	pb.DieStack = append(pb.DieStack, r)
+	log.Printf("INFO: Die stack element '%s' (%.0fmm) added to '%s'; stack is now %.0fmm.",
+		r.Name, r.HeightMM, pb.Name, pb.DieStackHeight())
+	return nil
+}
+
+// ClearDieStack removes all holders/risers.
+func (pb *PressBrake) ClearDieStack() {
+	pb.DieStack = nil
+	log.Printf("INFO: Die stack cleared on '%s'.", pb.Name)
+}
+
+// DieStackHeight is the total height of holders and risers under the die.
+func (pb *PressBrake) DieStackHeight() float64 {
+	total := 0.0
+	for _, r := range pb.DieStack {
+		total += r.HeightMM
+	}
+	return total
+}
+
+// EffectiveOpenHeight is the machine daylight left after the die stack
+// (0 when no open height is configured).
+func (pb *PressBrake) EffectiveOpenHeight() float64 {
+	if pb.OpenHeight <= 0 {
+		return pb.OpenHeight
+	}
+	h := pb.OpenHeight - pb.DieStackHeight()
+	if h < 0 {
+		h = 0
+	}
+	return h
+}
+
+// toolStackBlock renders the tool stack for the setup sheet, top to
+// bottom, with the daylight it leaves.
+func toolStackBlock(pb *PressBrake) string {
+	if pb == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\nTool Stack (top to bottom):\n")
+	if p := pb.GetCurrentPunch(); p != nil {
+		sb.WriteString(fmt.Sprintf("  Punch:  %s (%.0fmm)\n", p.Name, p.Height))
+	}
+	if d := pb.GetCurrentDie(); d != nil {
+		sb.WriteString(fmt.Sprintf("  Die:    %s (V%.0f)\n", d.Name, d.VOpening))
+	}
+	for _, r := range pb.DieStack {
+		sb.WriteString(fmt.Sprintf("  Riser:  %s (%.0fmm)\n", r.Name, r.HeightMM))
+	}
+	if pb.OpenHeight > 0 {
+		sb.WriteString(fmt.Sprintf("  Open height: %.0fmm rated, %.0fmm with stack installed\n",
+			pb.OpenHeight, pb.EffectiveOpenHeight()))
+	}
+	return sb.String()
+}
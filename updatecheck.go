@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// --- Update check ---
+// Shop PCs tend to run the same binary for years. The checker queries the
+// project's GitHub releases feed and reports when a newer version exists,
+// together with the release notes and download page. It is strictly
+// opt-in: nothing is contacted unless the user asks, and the request
+// carries no identifying payload — it is a plain GET of a public feed.
+
+// updateFeedURL is the releases feed queried for the latest version.
+const updateFeedURL = "https://api.github.com/repos/yackko/CNC-Press-Brake-Simulator/releases/latest"
+
+// UpdateInfo describes the latest published release.
+type UpdateInfo struct {
+	Version     string // Release tag, e.g. "v0.4.0".
+	Changelog   string // Release notes body.
+	DownloadURL string // Page with the release assets.
+	Newer       bool   // True when Version is newer than the running build.
+}
+
+// githubRelease is the subset of the releases feed we read.
+type githubRelease struct {
+	TagName string `json:"tag_name"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
+// CheckForUpdate queries the releases feed and compares the latest tag
+// against the running version. Call it only on explicit user request.
+func CheckForUpdate() (*UpdateInfo, error) {
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Get(updateFeedURL)
+	if err != nil {
+		return nil, fmt.Errorf("querying releases feed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("releases feed returned status %d", resp.StatusCode)
+	}
+	var rel githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+		return nil, fmt.Errorf("decoding releases feed: %w", err)
+	}
+	if rel.TagName == "" {
+		return nil, fmt.Errorf("releases feed carried no version tag")
+	}
+
+	info := &UpdateInfo{
+		Version:     rel.TagName,
+		Changelog:   strings.TrimSpace(rel.Body),
+		DownloadURL: rel.HTMLURL,
+		Newer:       versionNewer(rel.TagName, appVersion),
+	}
+	log.Printf("INFO: Update check: latest release %s, running %s (newer=%v).", info.Version, appVersion, info.Newer)
+	return info, nil
+}
+
+// versionNewer compares two "vMAJOR.MINOR.PATCH" tags numerically; any
+// suffix after the numbers (e.g. " (Pro)") is ignored. Unparseable tags
+// compare as not newer so a bad feed never nags the user.
+func versionNewer(latest, current string) bool {
+	ln, lok := versionNumbers(latest)
+	cn, cok := versionNumbers(current)
+	if !lok || !cok {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		if ln[i] != cn[i] {
+			return ln[i] > cn[i]
+		}
+	}
+	return false
+}
+
+// versionNumbers extracts the leading MAJOR.MINOR.PATCH from a tag.
+func versionNumbers(tag string) ([3]int, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "v")
+	if i := strings.IndexByte(tag, ' '); i >= 0 {
+		tag = tag[:i]
+	}
+	var n [3]int
+	parts := strings.Split(tag, ".")
+	if len(parts) < 2 {
+		return n, false
+	}
+	for i := 0; i < len(parts) && i < 3; i++ {
+		if _, err := fmt.Sscanf(parts[i], "%d", &n[i]); err != nil {
+			return n, false
+		}
+	}
+	return n, true
+}
+
+// FormatUpdateDialog renders the update result as dialog body text.
+func FormatUpdateDialog(info *UpdateInfo) string {
+	if !info.Newer {
+		return fmt.Sprintf("You are up to date (%s is the latest release).", info.Version)
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Version %s is available (you are running %s).\n\n", info.Version, appVersion))
+	if info.Changelog != "" {
+		sb.WriteString("Changes:\n")
+		sb.WriteString(info.Changelog)
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString("Download: " + info.DownloadURL)
+	return sb.String()
+}
+
+// handleCheckForUpdate runs the opt-in update check off the UI thread and
+// shows the outcome in a dialog.
+func (ac *AppController) handleCheckForUpdate() {
+	ac.updateStatus("Checking for updates...", false)
+	go func() {
+		info, err := CheckForUpdate()
+		if err != nil {
+			ac.updateStatus(fmt.Sprintf("Update check failed: %v", err), true)
+			return
+		}
+		ac.showConfirmDialog("Update Check", FormatUpdateDialog(info), nil, nil)
+		ac.signalUIUpdate()
+	}()
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"math"
+	"sort"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// --- 3D view of the formed part ---
+// The profile panel can flip into a wireframe 3D view: the folded
+// cross-section extruded along the sheet width, drawn with Gio path ops.
+// Dragging orbits the part (yaw/pitch), the scroll wheel zooms. The
+// geometry is the same bend walk the simulation records, so what rotates
+// on screen is exactly what ProcessJob produced.
+
+// 3D view tuning.
+const (
+	view3DInitialYawDeg   = 30.0
+	view3DInitialPitchDeg = -20.0
+	view3DMinZoom         = 0.2
+	view3DMaxZoom         = 8.0
+	view3DOrbitDegPerPx   = 0.4
+	view3DZoomPerScroll   = 1.1
+	view3DMarginPx        = 20.0
+)
+
+// vec3 is a point in part space (X along the blank, Y up, Z along width).
+type vec3 struct{ X, Y, Z float64 }
+
+// View3D holds the camera and input state of the 3D part view.
+type View3D struct {
+	YawDeg   float64
+	PitchDeg float64
+	Zoom     float64
+
+	dragging bool
+	lastPos  f32.Point
+	tag      struct{} // Pointer input tag.
+}
+
+// NewView3D returns a view with the default three-quarter camera.
+func NewView3D() *View3D {
+	return &View3D{YawDeg: view3DInitialYawDeg, PitchDeg: view3DInitialPitchDeg, Zoom: 1.0}
+}
+
+// ResetCamera restores the default orientation and zoom.
+func (v *View3D) ResetCamera() {
+	v.YawDeg, v.PitchDeg, v.Zoom = view3DInitialYawDeg, view3DInitialPitchDeg, 1.0
+}
+
+// foldedProfilePoints walks the sheet's recorded bends and returns the
+// cross-section polyline of the folded part (X along the original blank,
+// Y up). Bends are treated as sharp corners here; arc fidelity belongs to
+// the cross-section renderer.
+func foldedProfilePoints(sheet *SheetMetal) ([]vec3, error) {
+	if sheet == nil {
+		return nil, fmt.Errorf("sheet is nil for folded profile")
+	}
+	bends := make([]BendStep, len(sheet.CurrentBends))
+	copy(bends, sheet.CurrentBends)
+	sort.Slice(bends, func(a, b int) bool { return bends[a].Position < bends[b].Position })
+
+	pts := []vec3{{0, 0, 0}}
+	heading := 0.0 // Radians from +X, counter-clockwise.
+	pos := vec3{}
+	prev := 0.0
+	advance := func(dist float64) {
+		pos.X += dist * math.Cos(heading)
+		pos.Y += dist * math.Sin(heading)
+	}
+	for _, b := range bends {
+		if b.Position <= prev || b.Position >= sheet.OriginalLength {
+			continue
+		}
+		advance(b.Position - prev)
+		pts = append(pts, pos)
+		swept := (180.0 - b.TargetAngle) * math.Pi / 180.0
+		if b.Direction == BendDirectionDown {
+			swept = -swept
+		}
+		heading += swept
+		prev = b.Position
+	}
+	advance(sheet.OriginalLength - prev)
+	pts = append(pts, pos)
+	return pts, nil
+}
+
+// previewProfilePoints returns the cross-section polyline the 3D view
+// draws. With a step selected, the shared-geometry folded states supply
+// the part as formed up to and including that step — clicking down the
+// sequence list scrubs the preview without copying geometry per state.
+// With no selection the fully formed sheet is shown.
+func (ac *AppController) previewProfilePoints(sheet *SheetMetal) ([]vec3, error) {
+	j := ac.currentJob
+	if j == nil || ac.selectedStepIdx < 0 || ac.selectedStepIdx >= len(j.Steps) {
+		return foldedProfilePoints(sheet)
+	}
+	var upTo []*BendStep
+	for i, step := range j.Steps {
+		if i > ac.selectedStepIdx {
+			break
+		}
+		if step == nil || step.Disabled {
+			continue
+		}
+		upTo = append(upTo, step)
+	}
+	states, err := FoldedStates(sheet, upTo)
+	if err != nil {
+		return nil, err
+	}
+	state := states[len(states)-1]
+	pts := make([]vec3, 0, len(state.Profile.Segments)+1)
+	for i := range state.Profile.Segments {
+		a, b := state.Segment(i)
+		if i == 0 {
+			pts = append(pts, vec3{a.X, a.Y, 0})
+		}
+		pts = append(pts, vec3{b.X, b.Y, 0})
+	}
+	return pts, nil
+}
+
+// project maps a part-space point to screen coordinates for the current
+// camera: yaw about Y, then pitch about X, orthographic.
+func (v *View3D) project(p vec3) f32.Point {
+	yaw := v.YawDeg * math.Pi / 180.0
+	pitch := v.PitchDeg * math.Pi / 180.0
+	x := p.X*math.Cos(yaw) + p.Z*math.Sin(yaw)
+	z := -p.X*math.Sin(yaw) + p.Z*math.Cos(yaw)
+	y := p.Y*math.Cos(pitch) - z*math.Sin(pitch)
+	return f32.Pt(float32(x), float32(-y)) // Screen Y grows downward.
+}
+
+// handleOrbitInput consumes pointer events over the view area and updates
+// the camera. Returns true when the camera changed and a redraw is due.
+func (v *View3D) handleOrbitInput(gtx layout.Context, size image.Point) bool {
+	changed := false
+	for _, ev := range gtx.Queue.Events(&v.tag) {
+		pe, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+		switch pe.Kind {
+		case pointer.Press:
+			v.dragging = true
+			v.lastPos = pe.Position
+		case pointer.Drag:
+			if v.dragging {
+				v.YawDeg += float64(pe.Position.X-v.lastPos.X) * view3DOrbitDegPerPx
+				v.PitchDeg += float64(pe.Position.Y-v.lastPos.Y) * view3DOrbitDegPerPx
+				if v.PitchDeg > 89 {
+					v.PitchDeg = 89
+				}
+				if v.PitchDeg < -89 {
+					v.PitchDeg = -89
+				}
+				v.lastPos = pe.Position
+				changed = true
+			}
+		case pointer.Release, pointer.Cancel:
+			v.dragging = false
+		case pointer.Scroll:
+			if pe.Scroll.Y > 0 {
+				v.Zoom /= view3DZoomPerScroll
+			} else if pe.Scroll.Y < 0 {
+				v.Zoom *= view3DZoomPerScroll
+			}
+			if v.Zoom < view3DMinZoom {
+				v.Zoom = view3DMinZoom
+			}
+			if v.Zoom > view3DMaxZoom {
+				v.Zoom = view3DMaxZoom
+			}
+			changed = true
+		}
+	}
+	defer clip.Rect(image.Rectangle{Max: size}).Push(gtx.Ops).Pop()
+	pointer.InputOp{
+		Tag:          &v.tag,
+		Kinds:        pointer.Press | pointer.Drag | pointer.Release | pointer.Cancel | pointer.Scroll,
+		ScrollBounds: image.Rect(0, -120, 0, 120),
+	}.Add(gtx.Ops)
+	return changed
+}
+
+// strokePolyline draws one connected line strip.
+func strokePolyline(gtx layout.Context, pts []f32.Point, col color.NRGBA, width float32) {
+	if len(pts) < 2 {
+		return
+	}
+	var p clip.Path
+	p.Begin(gtx.Ops)
+	p.MoveTo(pts[0])
+	for _, pt := range pts[1:] {
+		p.LineTo(pt)
+	}
+	paint.FillShape(gtx.Ops, col, clip.Stroke{Path: p.End(), Width: width}.Op())
+}
+
+// layout3DView draws the folded part as a wireframe extrusion and handles
+// orbit/zoom input. The panel falls back to the 2D image when the 3D
+// feature is disabled.
+func (ac *AppController) layout3DView(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Max
+	if ac.currentJob == nil || ac.currentJob.Sheet == nil {
+		return layout.Dimensions{Size: size}
+	}
+	sheet := ac.currentJob.Sheet
+	profile, err := ac.previewProfilePoints(sheet)
+	if err != nil {
+		log.Printf("ERROR: 3D view geometry: %v", err)
+		return layout.Dimensions{Size: size}
+	}
+
+	if ac.view3D.handleOrbitInput(gtx, size) {
+		ac.win.Invalidate()
+	}
+
+	// Near and far edges of the extrusion, centered on the width.
+	half := sheet.Width / 2
+	near := make([]f32.Point, len(profile))
+	far := make([]f32.Point, len(profile))
+	minPt := f32.Pt(math.MaxFloat32, math.MaxFloat32)
+	maxPt := f32.Pt(-math.MaxFloat32, -math.MaxFloat32)
+	track := func(p f32.Point) {
+		if p.X < minPt.X {
+			minPt.X = p.X
+		}
+		if p.Y < minPt.Y {
+			minPt.Y = p.Y
+		}
+		if p.X > maxPt.X {
+			maxPt.X = p.X
+		}
+		if p.Y > maxPt.Y {
+			maxPt.Y = p.Y
+		}
+	}
+	for i, p := range profile {
+		near[i] = ac.view3D.project(vec3{p.X, p.Y, -half})
+		far[i] = ac.view3D.project(vec3{p.X, p.Y, half})
+		track(near[i])
+		track(far[i])
+	}
+
+	// Fit the projection into the panel, then apply the user zoom.
+	spanX := float64(maxPt.X-minPt.X) + 1e-6
+	spanY := float64(maxPt.Y-minPt.Y) + 1e-6
+	scale := math.Min(
+		(float64(size.X)-2*view3DMarginPx)/spanX,
+		(float64(size.Y)-2*view3DMarginPx)/spanY,
+	) * ac.view3D.Zoom
+	cx := float64(minPt.X+maxPt.X) / 2
+	cy := float64(minPt.Y+maxPt.Y) / 2
+	toScreen := func(p f32.Point) f32.Point {
+		return f32.Pt(
+			float32((float64(p.X)-cx)*scale)+float32(size.X)/2,
+			float32((float64(p.Y)-cy)*scale)+float32(size.Y)/2,
+		)
+	}
+	for i := range near {
+		near[i] = toScreen(near[i])
+		far[i] = toScreen(far[i])
+	}
+
+	edgeColor := color.NRGBA{R: 0x26, G: 0x4A, B: 0x73, A: 0xFF}
+	ribColor := color.NRGBA{R: 0x90, G: 0xA8, B: 0xC0, A: 0xFF}
+	strokePolyline(gtx, near, edgeColor, 2)
+	strokePolyline(gtx, far, edgeColor, 2)
+	for i := range near {
+		strokePolyline(gtx, []f32.Point{near[i], far[i]}, ribColor, 1)
+	}
+	return layout.Dimensions{Size: size}
+}
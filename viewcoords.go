@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Part coordinate mapping and snapping ---
+// The profile/flat views draw the sheet with a fixed 20px padding on each
+// side (see GenerateSVGProfile), scaled to fit the widget. These helpers
+// convert widget pixels back to part millimeters and snap loose cursor
+// positions onto the features users actually mean: bend lines, the sheet
+// ends, and round values.
+
+// snapGridMM is the round-value grid used when no feature is nearby.
+const snapGridMM = 5.0
+
+// svgPaddingPx is the horizontal padding GenerateSVGProfile draws around
+// the sheet, in SVG units.
+const svgPaddingPx = 20.0
+
+// ViewMapper converts between displayed-view pixels and part coordinates
+// for a sheet drawn by the SVG exporter and scaled to widthPx on screen.
+type ViewMapper struct {
+	SheetLength float64 // mm
+	WidthPx     float64 // Displayed width of the whole view in pixels.
+	Flipped     bool    // Reference edge flipped (positions from the far end).
+}
+
+// PartX converts a view pixel X to a part coordinate in mm. The second
+// return is false when the pixel lies outside the sheet.
+func (vm ViewMapper) PartX(px float64) (float64, bool) {
+	if vm.WidthPx <= 0 || vm.SheetLength <= 0 {
+		return 0, false
+	}
+	scale := vm.WidthPx / (vm.SheetLength + 2*svgPaddingPx)
+	x := px/scale - svgPaddingPx
+	if x < 0 || x > vm.SheetLength {
+		return 0, false
+	}
+	if vm.Flipped {
+		x = vm.SheetLength - x
+	}
+	return x, true
+}
+
+// SnapTarget describes what a snapped coordinate landed on.
+type SnapTarget int
+
+const (
+	SnapNone SnapTarget = iota // Free position (snapped to the round-value grid).
+	SnapBendLine
+	SnapSheetEdge
+)
+
+// SnapResult is a snapped part coordinate and what it snapped to.
+type SnapResult struct {
+	X         float64
+	Target    SnapTarget
+	StepIndex int // Index into the step list when Target is SnapBendLine.
+}
+
+// SnapPartX snaps a raw part coordinate to the nearest feature within
+// tolMM: bend lines win over sheet edges, which win over the value grid.
+func SnapPartX(x float64, sheet *SheetMetal, steps []*BendStep, tolMM float64) SnapResult {
+	res := SnapResult{X: math.Round(x/snapGridMM) * snapGridMM, Target: SnapNone, StepIndex: -1}
+	if sheet == nil {
+		return res
+	}
+
+	bestDist := tolMM
+	for i, step := range steps {
+		if step == nil {
+			continue
+		}
+		if d := math.Abs(step.Position - x); d <= bestDist {
+			res = SnapResult{X: step.Position, Target: SnapBendLine, StepIndex: i}
+			bestDist = d
+		}
+	}
+	if res.Target == SnapBendLine {
+		return res
+	}
+	for _, edge := range []float64{0, sheet.OriginalLength} {
+		if d := math.Abs(edge - x); d <= bestDist {
+			res = SnapResult{X: edge, Target: SnapSheetEdge, StepIndex: -1}
+			bestDist = d
+		}
+	}
+	return res
+}
+
+// FormatCursorReadout renders the status text for a hovered position.
+func FormatCursorReadout(snap SnapResult, steps []*BendStep) string {
+	switch snap.Target {
+	case SnapBendLine:
+		if snap.StepIndex >= 0 && snap.StepIndex < len(steps) && steps[snap.StepIndex] != nil {
+			return fmt.Sprintf("X: %.1fmm (bend %d)", snap.X, steps[snap.StepIndex].SequenceOrder)
+		}
+		return fmt.Sprintf("X: %.1fmm (bend line)", snap.X)
+	case SnapSheetEdge:
+		return fmt.Sprintf("X: %.1fmm (sheet edge)", snap.X)
+	default:
+		return fmt.Sprintf("X: %.1fmm", snap.X)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !viewer
+
+package main
+
+// viewerMode is false in the normal editor build. Building with
+// `-tags viewer` produces the read-only distribution binary; see
+// viewer_on.go and viewermode.go.
+const viewerMode = false
@@ -0,0 +1,8 @@
+//go:build viewer
+
+package main
+
+// viewerMode is true in the read-only viewer build (`go build -tags
+// viewer`), intended for shop-floor terminals and customer review:
+// everything displays, nothing edits.
+const viewerMode = true
@@ -0,0 +1,26 @@
+package main
+
+// --- Viewer mode support ---
+// The viewer build (see viewer_on.go / viewer_off.go) shares all code
+// with the editor; mutation entry points call blockInViewerMode first
+// and back out when the build is read-only. Keeping it a build tag
+// rather than a runtime switch means a distributed viewer binary cannot
+// simply be reconfigured into an editor.
+
+// blockInViewerMode reports whether an edit action must be refused, and
+// tells the user why via the status bar.
+func (ac *AppController) blockInViewerMode() bool {
+	if !viewerMode {
+		return false
+	}
+	ac.updateStatus("Viewer mode: editing is disabled in this build.", true)
+	return true
+}
+
+// viewerTitleSuffix marks the window title in viewer builds.
+func viewerTitleSuffix() string {
+	if viewerMode {
+		return " (Viewer)"
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// --- Whip damping / part support recommendation ---
+// A long, heavy front flange does not just endanger the operator as it
+// whips up — one person cannot follow it, and letting it fall marks the
+// part and jerks the sheet off the gauges. From the whip-up result and
+// the flange mass this module recommends sheet followers or extra
+// operators, adds their handling time to the cycle estimate, and puts the
+// recommendation on the setup sheet where the foreman plans the crew.
+
+const (
+	// whipSupportHeightMM: flanges whipping higher than this want support.
+	whipSupportHeightMM = 250.0
+	// whipFollowerMassKg: above this flange mass, sheet followers are
+	// recommended over a second pair of hands.
+	whipFollowerMassKg = 15.0
+	// whipSecondOperatorMassKg: above this, recommend a second operator
+	// even with followers fitted.
+	whipSecondOperatorMassKg = 35.0
+	// whipHandlingSecPerStep is the extra cycle time per supported step.
+	whipHandlingSecPerStep = 4.0
+)
+
+// WhipSupportAdvice is the recommendation for one step.
+type WhipSupportAdvice struct {
+	StepIndex      int     // 0-based index into the job's steps.
+	FlangeMassKg   float64 // Mass of the whipping front flange.
+	NeedsFollowers bool
+	ExtraOperator  bool
+	ExtraCycleSec  float64
+	Message        string
+}
+
+// flangeMassKg returns the mass of the front flange ahead of the bend line.
+func flangeMassKg(sheet *SheetMetal, position float64) float64 {
+	if sheet == nil {
+		return 0
+	}
+	volumeM3 := (position / 1000) * (sheet.Width / 1000) * (sheet.Thickness / 1000)
+	return volumeM3 * sheet.Material.Density
+}
+
+// RecommendWhipSupport walks the enabled steps and recommends support for
+// those whose flanges whip high and heavy.
+func RecommendWhipSupport(j *Job) ([]WhipSupportAdvice, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for whip support recommendation")
+	}
+	var advice []WhipSupportAdvice
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled || step.Direction != BendDirectionUp {
+			continue
+		}
+		whip, err := WhipUpForStep(j.Sheet, step)
+		if err != nil || whip.MaxHeight < whipSupportHeightMM {
+			continue
+		}
+		mass := flangeMassKg(j.Sheet, step.Position)
+		if mass < whipFollowerMassKg {
+			continue
+		}
+		a := WhipSupportAdvice{
+			StepIndex:      i,
+			FlangeMassKg:   mass,
+			NeedsFollowers: true,
+			ExtraOperator:  mass >= whipSecondOperatorMassKg,
+			ExtraCycleSec:  whipHandlingSecPerStep,
+		}
+		what := "sheet followers"
+		if a.ExtraOperator {
+			what = "sheet followers and a second operator"
+		}
+		a.Message = fmt.Sprintf("step %d: %.0fkg flange whips to %.0fmm - use %s",
+			step.SequenceOrder, mass, whip.MaxHeight, what)
+		advice = append(advice, a)
+	}
+	return advice, nil
+}
+
+// WhipSupportExtraSeconds sums the handling time the recommendations add
+// to one part's cycle.
+func WhipSupportExtraSeconds(j *Job) float64 {
+	advice, err := RecommendWhipSupport(j)
+	if err != nil {
+		return 0
+	}
+	total := 0.0
+	for _, a := range advice {
+		total += a.ExtraCycleSec
+	}
+	return total
+}
+
+// whipSupportBlock renders the recommendations for the setup sheet ("" when
+// no step needs support).
+func whipSupportBlock(j *Job) string {
+	advice, err := RecommendWhipSupport(j)
+	if err != nil || len(advice) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\nPart Support:\n")
+	for _, a := range advice {
+		sb.WriteString("  " + a.Message + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// --- Flange whip-up during the stroke ---
+// As the punch descends, the free flange rotates up around the die opening;
+// on long parts the tip sweeps a large, fast-moving arc. The tip path and
+// swept zone are computed per step so the animation can draw them and the
+// validator can warn when the zone reaches the operator or machine frame.
+
+// whipUpPathSamples is the number of positions sampled along the stroke.
+const whipUpPathSamples = 24
+
+// Default safety envelope in front of the machine: a flange tip sweeping
+// higher than this above the die line, within operator reach, is flagged.
+var operatorZoneHeight = 400.0 // mm above the die line
+
+// WhipUpResult describes the swept zone of the front flange for one step.
+type WhipUpResult struct {
+	TipPath      []Point2 // Sampled flange tip positions, die line at origin.
+	MaxHeight    float64  // Highest point of the tip above the die line (mm).
+	MaxReach     float64  // Furthest horizontal extent of the tip (mm).
+	OperatorRisk bool     // Swept zone reaches the operator envelope.
+	Message      string
+}
+
+// WhipUpForStep computes the flange tip path for one bend step. The flange
+// length is taken from the bend position (front flange, toward the operator);
+// the tip rotates from flat up to the final bend angle.
+func WhipUpForStep(sheet *SheetMetal, step *BendStep) (*WhipUpResult, error) {
+	if sheet == nil || step == nil {
+		return nil, fmt.Errorf("sheet or step is nil for whip-up calculation")
+	}
+	flange := step.Position // Front flange length (mm).
+	if flange <= 0 {
+		return nil, fmt.Errorf("step position %.2fmm leaves no front flange", flange)
+	}
+
+	// Final rotation of the flange relative to flat: 180° minus the internal
+	// bend angle (a 90° bend rotates the flange by 90°).
+	finalRot := (180.0 - step.TargetAngle) * math.Pi / 180.0
+
+	res := &WhipUpResult{TipPath: make([]Point2, 0, whipUpPathSamples+1)}
+	for i := 0; i <= whipUpPathSamples; i++ {
+		rot := finalRot * float64(i) / float64(whipUpPathSamples)
+		tip := Point2{X: flange * math.Cos(rot), Y: flange * math.Sin(rot)}
+		if step.Direction == BendDirectionDown {
+			tip.Y = -tip.Y
+		}
+		res.TipPath = append(res.TipPath, tip)
+		if h := math.Abs(tip.Y); h > res.MaxHeight {
+			res.MaxHeight = h
+		}
+		if r := math.Abs(tip.X); r > res.MaxReach {
+			res.MaxReach = r
+		}
+	}
+
+	if step.Direction == BendDirectionUp && res.MaxHeight > operatorZoneHeight {
+		res.OperatorRisk = true
+		res.Message = fmt.Sprintf("Flange whip-up reaches %.0fmm above the die line (limit %.0fmm): keep clear of the swept zone.",
+			res.MaxHeight, operatorZoneHeight)
+	}
+	return res, nil
+}
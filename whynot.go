@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// --- Sequence rejection explanations ---
+// "Sequence invalid" teaches nobody anything. When a user-chosen bend
+// order is rejected, each offending step gets a plain-language reason and
+// a snapshot of the part state at that point in the sequence, so the user
+// can see what collides or no longer fits instead of guessing.
+
+// SequenceRejection explains why one step fails in the chosen order.
+type SequenceRejection struct {
+	StepIndex    int    // 0-based index into the job's step list.
+	Reason       string // Human-readable explanation.
+	SnapshotPath string // SVG of the part state before the failing step ("" if none).
+}
+
+// ExplainSequence replays the job's enabled steps in their current order
+// over the shared-geometry folded states, so the whip-up and machine-fit
+// checks judge each step against the part as formed by the steps before
+// it — moving a step earlier or later changes its verdict. (Coining risk
+// is a per-step force property and is checked as such.) One rejection is
+// returned per failing step; snapshots are written into snapshotDir
+// (skipped when empty). An empty result means the order is feasible.
+func ExplainSequence(j *Job, pb *PressBrake, snapshotDir string) ([]SequenceRejection, error) {
+	if j == nil || j.Sheet == nil {
+		return nil, fmt.Errorf("job or sheet is nil for sequence explanation")
+	}
+
+	indices := make([]int, 0, len(j.Steps))
+	steps := make([]*BendStep, 0, len(j.Steps))
+	for i, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		indices = append(indices, i)
+		steps = append(steps, step)
+	}
+	states, err := FoldedStates(j.Sheet, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejections []SequenceRejection
+	var die *Die
+	if pb != nil {
+		die = pb.GetCurrentDie()
+	}
+
+	formed := make([]BendStep, 0, len(steps))
+	for k, step := range steps {
+		i := indices[k]
+		before, after := states[k], states[k+1]
+		reason := ""
+
+		// The front portion rotating during this stroke carries every bend
+		// already formed in it, so its lever arm about the bend line is the
+		// farthest formed vertex ahead of the line, not the flat flange
+		// length.
+		probe := *step
+		probe.Position = frontReach(before, step.Position)
+		if whip, whipErr := WhipUpForStep(j.Sheet, &probe); whipErr == nil && whip.OperatorRisk {
+			reason = fmt.Sprintf("step %d after %d earlier bends: %s", i+1, len(formed), whip.Message)
+		}
+		if reason == "" && pb != nil {
+			rise, rearDepth := formedFit(after, step.Position)
+			if daylight := pb.EffectiveOpenHeight(); daylight > 0 && step.Direction == BendDirectionUp && rise > daylight {
+				reason = fmt.Sprintf("step %d with the part as formed so far: it rises %.0fmm above the die line, exceeding the available open height (%.0fmm)",
+					i+1, rise, daylight)
+			} else if pb.ThroatDepth > 0 && step.Direction == BendDirectionDown && rearDepth > pb.ThroatDepth {
+				reason = fmt.Sprintf("step %d with the part as formed so far: its rear reaches %.0fmm into the frame gap, beyond the throat depth (%.0fmm)",
+					i+1, rearDepth, pb.ThroatDepth)
+			}
+		}
+		if reason == "" && die != nil {
+			if assess, cErr := AssessCoiningRisk(j.Sheet, step, die, pb); cErr == nil && (assess.DieDamageRisk || assess.MachineOverload) {
+				reason = fmt.Sprintf("step %d: %s", i+1, assess.Message)
+			}
+		}
+
+		if reason != "" {
+			rej := SequenceRejection{StepIndex: i, Reason: reason}
+			if snapshotDir != "" {
+				rej.SnapshotPath = sequenceSnapshot(j, formed, i, snapshotDir)
+			}
+			rejections = append(rejections, rej)
+		}
+		formed = append(formed, *step)
+	}
+	return rejections, nil
+}
+
+// frontReach returns the longest lever arm of the already-formed front
+// portion about the bend line at the given flat position: the distance
+// from the bend line to the farthest vertex ahead of it. On a flat sheet
+// this is just the bend position.
+func frontReach(state *FoldedState, position float64) float64 {
+	pivot := state.BendLinePoint(position)
+	reach := 0.0
+	for i, seg := range state.Profile.Segments {
+		if seg.Start.X >= position {
+			continue
+		}
+		a, b := state.Segment(i)
+		for _, p := range []Point2{a, b} {
+			if d := math.Hypot(p.X-pivot.X, p.Y-pivot.Y); d > reach {
+				reach = d
+			}
+		}
+	}
+	return reach
+}
+
+// formedFit measures the part, with the current bend applied, as it sits
+// in the machine: oriented so the segment ahead of the bend line lies flat
+// on the die. It returns the highest rise above the die line and the
+// deepest horizontal reach of the formed material behind the bend line,
+// the two extents the open-height and throat checks compare against.
+func formedFit(state *FoldedState, position float64) (rise, rearDepth float64) {
+	pivot := state.BendLinePoint(position)
+	dirX, dirY := 1.0, 0.0
+	for i, seg := range state.Profile.Segments {
+		if seg.Start.X < position && seg.End.X >= position {
+			a, b := state.Segment(i)
+			if l := math.Hypot(b.X-a.X, b.Y-a.Y); l > 0 {
+				dirX, dirY = (b.X-a.X)/l, (b.Y-a.Y)/l
+			}
+			break
+		}
+	}
+	for i, seg := range state.Profile.Segments {
+		a, b := state.Segment(i)
+		for _, p := range []Point2{a, b} {
+			// Coordinates in the die frame: X along the flat flange, Y up.
+			rx := (p.X-pivot.X)*dirX + (p.Y-pivot.Y)*dirY
+			ry := (p.Y-pivot.Y)*dirX - (p.X-pivot.X)*dirY
+			if ry > rise {
+				rise = ry
+			}
+			if seg.Start.X >= position && rx > rearDepth {
+				rearDepth = rx
+			}
+		}
+	}
+	return rise, rearDepth
+}
+
+// sequenceSnapshot renders the part with only the bends formed before the
+// failing step, returning the written path ("" on failure — the
+// explanation is still useful without the picture).
+func sequenceSnapshot(j *Job, formed []BendStep, failingIdx int, dir string) string {
+	saved := j.Sheet.CurrentBends
+	j.Sheet.CurrentBends = formed
+	defer func() { j.Sheet.CurrentBends = saved }()
+
+	path := filepath.Join(dir, fmt.Sprintf("seq_reject_step%d.svg", failingIdx+1))
+	if err := GenerateSVGProfile(j.Sheet, nil, j.ReferenceFlipped, path); err != nil {
+		log.Printf("WARNING: Could not write sequence snapshot for step %d: %v", failingIdx+1, err)
+		return ""
+	}
+	return path
+}
+
+// FormatSequenceRejections renders the rejections as a multi-line message
+// for the dialog, or "" when the sequence is fine.
+func FormatSequenceRejections(rejections []SequenceRejection) string {
+	if len(rejections) == 0 {
+		return ""
+	}
+	msg := fmt.Sprintf("Sequence rejected (%d problem(s)):\n", len(rejections))
+	for _, r := range rejections {
+		msg += "  - " + r.Reason + "\n"
+		if r.SnapshotPath != "" {
+			msg += "    Snapshot: " + filepath.Base(r.SnapshotPath) + "\n"
+		}
+	}
+	return msg
+}
+
+// cleanupSequenceSnapshots removes snapshot files from earlier runs.
+func cleanupSequenceSnapshots(dir string) {
+	matches, err := filepath.Glob(filepath.Join(dir, "seq_reject_step*.svg"))
+	if err != nil {
+		return
+	}
+	for _, m := range matches {
+		_ = os.Remove(m)
+	}
+}
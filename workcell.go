@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// --- Workcell export ---
+// Robot integrators and offline-programming packages build a bending cell
+// around the press data: machine envelope, tool geometry, part blank and
+// the programmed sequence. This exporter writes all of it as one neutral
+// JSON bundle with explicit units so any consumer can reconstruct the
+// cell without parsing controller formats.
+
+// workcellSchemaVersion identifies the bundle layout for consumers.
+const workcellSchemaVersion = "1.0"
+
+// WorkcellMachine describes the press brake envelope.
+type WorkcellMachine struct {
+	Name        string  `json:"name"`
+	MaxTonnage  float64 `json:"max_tonnage_t"`
+	BedLengthMM float64 `json:"bed_length_mm"`
+	OpenHeight  float64 `json:"open_height_mm"`
+	ThroatDepth float64 `json:"throat_depth_mm"`
+	Clamping    string  `json:"clamping"`
+}
+
+// WorkcellTool describes one mounted tool.
+type WorkcellTool struct {
+	Name             string  `json:"name"`
+	Kind             string  `json:"kind"` // "punch" or "die".
+	HeightMM         float64 `json:"height_mm,omitempty"`
+	AngleDeg         float64 `json:"angle_deg"`
+	TipRadiusMM      float64 `json:"tip_radius_mm,omitempty"`
+	VOpeningMM       float64 `json:"v_opening_mm,omitempty"`
+	ShoulderRadiusMM float64 `json:"shoulder_radius_mm,omitempty"`
+	MaxLoadKNPerM    float64 `json:"max_load_kn_per_m"`
+}
+
+// WorkcellAccessory describes one bed-mounted accessory.
+type WorkcellAccessory struct {
+	Name     string  `json:"name"`
+	Kind     string  `json:"kind"`
+	XStartMM float64 `json:"x_start_mm"`
+	XEndMM   float64 `json:"x_end_mm"`
+	HeightMM float64 `json:"height_mm"`
+}
+
+// WorkcellBend is one programmed bend in machine terms.
+type WorkcellBend struct {
+	SequenceOrder   int     `json:"sequence_order"`
+	PositionMM      float64 `json:"position_mm"`
+	TargetAngleDeg  float64 `json:"target_angle_deg"` // Internal angle.
+	RadiusMM        float64 `json:"radius_mm"`
+	Direction       string  `json:"direction"`
+	StationOffsetMM float64 `json:"station_offset_mm"`
+}
+
+// WorkcellPart describes the blank and the folded result.
+type WorkcellPart struct {
+	Name          string       `json:"name"`
+	Material      string       `json:"material"`
+	BlankLengthMM float64      `json:"blank_length_mm"`
+	WidthMM       float64      `json:"width_mm"`
+	ThicknessMM   float64      `json:"thickness_mm"`
+	MassKg        float64      `json:"mass_kg"`
+	FoldedProfile [][2]float64 `json:"folded_profile_mm"` // Cross-section polyline [x, y].
+}
+
+// WorkcellBundle is the complete neutral export.
+type WorkcellBundle struct {
+	Schema      string              `json:"schema"`
+	Generator   string              `json:"generator"`
+	ExportedAt  time.Time           `json:"exported_at"`
+	Units       string              `json:"units"` // Always "mm/deg/t/kg".
+	Machine     WorkcellMachine     `json:"machine"`
+	Tools       []WorkcellTool      `json:"tools"`
+	Accessories []WorkcellAccessory `json:"accessories,omitempty"`
+	Part        WorkcellPart        `json:"part"`
+	Bends       []WorkcellBend      `json:"bends"`
+}
+
+// BuildWorkcellBundle assembles the bundle from the job and machine state.
+func BuildWorkcellBundle(j *Job, pb *PressBrake) (*WorkcellBundle, error) {
+	if j == nil || j.Sheet == nil || pb == nil {
+		return nil, fmt.Errorf("job, sheet or press brake is nil for workcell export")
+	}
+
+	bundle := &WorkcellBundle{
+		Schema:     workcellSchemaVersion,
+		Generator:  appName + " " + appVersion,
+		ExportedAt: time.Now(),
+		Units:      "mm/deg/t/kg",
+		Machine: WorkcellMachine{
+			Name:        pb.Name,
+			MaxTonnage:  pb.MaxTonnage,
+			BedLengthMM: pb.BedLength(),
+			OpenHeight:  pb.OpenHeight,
+			ThroatDepth: pb.ThroatDepth,
+			Clamping:    string(pb.Clamping),
+		},
+	}
+	if p := pb.GetCurrentPunch(); p != nil {
+		bundle.Tools = append(bundle.Tools, WorkcellTool{
+			Name: p.Name, Kind: "punch", HeightMM: p.Height, AngleDeg: p.Angle,
+			TipRadiusMM: p.Radius, MaxLoadKNPerM: p.MaxLoadKNPerM,
+		})
+	}
+	if d := pb.GetCurrentDie(); d != nil {
+		bundle.Tools = append(bundle.Tools, WorkcellTool{
+			Name: d.Name, Kind: "die", AngleDeg: d.Angle,
+			VOpeningMM: d.VOpening, ShoulderRadiusMM: d.ShoulderRadius, MaxLoadKNPerM: d.MaxLoadKNPerM,
+		})
+	}
+	for _, acc := range pb.Accessories {
+		bundle.Accessories = append(bundle.Accessories, WorkcellAccessory{
+			Name: acc.Name, Kind: string(acc.Kind),
+			XStartMM: acc.XStartMM, XEndMM: acc.XEndMM, HeightMM: acc.HeightMM,
+		})
+	}
+
+	bundle.Part = WorkcellPart{
+		Name:          j.Name,
+		Material:      string(j.Sheet.Material.Name),
+		BlankLengthMM: j.Sheet.OriginalLength,
+		WidthMM:       j.Sheet.Width,
+		ThicknessMM:   j.Sheet.Thickness,
+		MassKg:        flangeMassKg(j.Sheet, j.Sheet.OriginalLength),
+	}
+	if profile, err := foldedProfilePoints(j.Sheet); err == nil {
+		for _, p := range profile {
+			bundle.Part.FoldedProfile = append(bundle.Part.FoldedProfile, [2]float64{p.X, p.Y})
+		}
+	}
+
+	for _, step := range j.Steps {
+		if step == nil || step.Disabled {
+			continue
+		}
+		bundle.Bends = append(bundle.Bends, WorkcellBend{
+			SequenceOrder:   step.SequenceOrder,
+			PositionMM:      step.Position,
+			TargetAngleDeg:  step.TargetAngle,
+			RadiusMM:        step.Radius,
+			Direction:       string(step.Direction),
+			StationOffsetMM: step.StationOffsetMM,
+		})
+	}
+	return bundle, nil
+}
+
+// ExportWorkcellBundle writes the bundle as indented JSON.
+func ExportWorkcellBundle(j *Job, pb *PressBrake, filePath string) error {
+	bundle, err := BuildWorkcellBundle(j, pb)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding workcell bundle: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("writing workcell bundle '%s': %w", filePath, err)
+	}
+	log.Printf("INFO: Workcell bundle for job '%s' exported to '%s' (%d bend(s)).", j.Name, filePath, len(bundle.Bends))
+	return nil
+}